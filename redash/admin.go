@@ -0,0 +1,130 @@
+package redash
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// OutdatedQueries は定期実行の更新が遅れているクエリの一覧
+type OutdatedQueries struct {
+	Queries   []OutdatedQuery `json:"queries"`
+	UpdatedAt interface{}     `json:"updated_at"` // 一覧を最後に更新した時刻（バージョンにより文字列または UNIX 時間）
+}
+
+// OutdatedQuery は更新が遅れているクエリと最新の結果の取得時刻
+type OutdatedQuery struct {
+	Query
+	RetrievedAt string   `json:"retrieved_at,omitempty"` // 最新の結果を取得した時刻
+	Runtime     *float64 `json:"runtime,omitempty"`      // 最新の結果の実行時間（秒）
+}
+
+// RQStatus はジョブキューとワーカーの状態
+type RQStatus struct {
+	Queues  map[string]RQQueue `json:"queues"`
+	Workers []RQWorker         `json:"workers"`
+}
+
+// RQQueue はジョブキューの状態
+type RQQueue struct {
+	Name    string  `json:"name"`
+	Started []RQJob `json:"started"` // 実行中のジョブ
+	Queued  int     `json:"queued"`  // 実行待ちのジョブ数
+}
+
+// RQJob は実行中のジョブ
+type RQJob struct {
+	ID         string                 `json:"id"` // cancel_job に渡すジョブID
+	Name       string                 `json:"name"`
+	Origin     string                 `json:"origin"`
+	EnqueuedAt string                 `json:"enqueued_at"`
+	StartedAt  string                 `json:"started_at"`
+	Meta       map[string]interface{} `json:"meta"` // クエリ実行の場合は query_id, data_source_id, user_id, scheduled など
+}
+
+// RQWorker はワーカーの状態
+type RQWorker struct {
+	Name             string  `json:"name"`
+	Hostname         string  `json:"hostname"`
+	PID              int     `json:"pid"`
+	Queues           string  `json:"queues"` // 担当するキュー（カンマ区切り）
+	State            string  `json:"state"`  // "busy", "idle" など
+	LastHeartbeat    string  `json:"last_heartbeat"`
+	BirthDate        string  `json:"birth_date"`
+	CurrentJob       *string `json:"current_job"` // "<ジョブID> (<関数名>)"
+	SuccessfulJobs   int     `json:"successful_jobs"`
+	FailedJobs       int     `json:"failed_jobs"`
+	TotalWorkingTime float64 `json:"total_working_time"`
+}
+
+// Runtime はジョブの開始からの経過時間を返す
+func (j RQJob) Runtime(now time.Time) (time.Duration, error) {
+	startedAt, err := parseTime(j.StartedAt)
+	if err != nil {
+		return 0, err
+	}
+	return now.Sub(startedAt), nil
+}
+
+// GetOutdatedQueries は定期実行の更新が遅れているクエリの一覧を取得（管理者のみ）
+func (c *Client) GetOutdatedQueries() (*OutdatedQueries, error) {
+	url := fmt.Sprintf("%s/api/admin/queries/outdated", c.BaseURL)
+
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Key %s", c.APIKey))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	var outdated OutdatedQueries
+	if err := json.NewDecoder(resp.Body).Decode(&outdated); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &outdated, nil
+}
+
+// GetRQStatus はジョブキューとワーカーの状態を取得（管理者のみ）
+// RQ を使用する Redash（v9 以降）が対象。Celery を使用する古いバージョンには存在しない
+func (c *Client) GetRQStatus() (*RQStatus, error) {
+	url := fmt.Sprintf("%s/api/admin/queries/rq_status", c.BaseURL)
+
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Key %s", c.APIKey))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	var status RQStatus
+	if err := json.NewDecoder(resp.Body).Decode(&status); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &status, nil
+}
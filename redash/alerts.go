@@ -0,0 +1,224 @@
+package redash
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// CreateAlertInput はアラート作成時の入力
+type CreateAlertInput struct {
+	Name    string       `json:"name"`
+	QueryID int          `json:"query_id"`
+	Options AlertOptions `json:"options"`
+	Rearm   *int         `json:"rearm"`
+}
+
+// UpdateAlertInput はアラート更新時の入力
+// nil のフィールドは送信せず、Redash 側の値を変更しない
+// Redash は options を丸ごと置き換えるため、Options は現在の Alert.RawOptions に変更をマージして渡す
+type UpdateAlertInput struct {
+	Name    *string                `json:"name,omitempty"`
+	QueryID *int                   `json:"query_id,omitempty"`
+	Options map[string]interface{} `json:"options,omitempty"`
+	Rearm   *int                   `json:"rearm,omitempty"`
+}
+
+// UnmarshalJSON はアラートを読み込み、options を RawOptions にもそのまま保持する
+func (a *Alert) UnmarshalJSON(data []byte) error {
+	type alert Alert
+	var decoded alert
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+
+	var raw struct {
+		Options map[string]interface{} `json:"options"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	*a = Alert(decoded)
+	a.RawOptions = raw.Options
+	return nil
+}
+
+// ListAlerts はアラートの一覧を取得
+func (c *Client) ListAlerts() ([]Alert, error) {
+	url := fmt.Sprintf("%s/api/alerts", c.BaseURL)
+
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Key %s", c.APIKey))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	var alerts []Alert
+	if err := json.NewDecoder(resp.Body).Decode(&alerts); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return alerts, nil
+}
+
+// CreateAlert は新しいアラートを作成
+func (c *Client) CreateAlert(input CreateAlertInput) (*Alert, error) {
+	url := fmt.Sprintf("%s/api/alerts", c.BaseURL)
+
+	data, err := json.Marshal(input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequest("POST", url, bytes.NewBuffer(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Key %s", c.APIKey))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	var alert Alert
+	if err := json.NewDecoder(resp.Body).Decode(&alert); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &alert, nil
+}
+
+// UpdateAlert は既存のアラートを更新
+func (c *Client) UpdateAlert(alertID int, input UpdateAlertInput) (*Alert, error) {
+	url := fmt.Sprintf("%s/api/alerts/%d", c.BaseURL, alertID)
+
+	data, err := json.Marshal(input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequest("POST", url, bytes.NewBuffer(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Key %s", c.APIKey))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	var alert Alert
+	if err := json.NewDecoder(resp.Body).Decode(&alert); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &alert, nil
+}
+
+// MuteAlert はアラートの通知を停止
+func (c *Client) MuteAlert(alertID int) error {
+	url := fmt.Sprintf("%s/api/alerts/%d/mute", c.BaseURL, alertID)
+
+	req, err := http.NewRequest("POST", url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Key %s", c.APIKey))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	return nil
+}
+
+// UnmuteAlert はアラートの通知を再開
+func (c *Client) UnmuteAlert(alertID int) error {
+	url := fmt.Sprintf("%s/api/alerts/%d/mute", c.BaseURL, alertID)
+
+	req, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Key %s", c.APIKey))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	return nil
+}
+
+// DeleteAlert はアラートを削除
+func (c *Client) DeleteAlert(alertID int) error {
+	url := fmt.Sprintf("%s/api/alerts/%d", c.BaseURL, alertID)
+
+	req, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Key %s", c.APIKey))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	return nil
+}
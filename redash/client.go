@@ -48,15 +48,26 @@ type QueryExecuteResponse struct {
 }
 
 type QueryJob struct {
-	ID          string       `json:"id"`
-	Status      int          `json:"status"` // 1: pending, 2: started, 3: success, 4: failure
-	Error       string       `json:"error,omitempty"`
-	QueryResult *QueryResult `json:"query_result,omitempty"`
+	ID            string          `json:"id"`
+	Status        int             `json:"status"` // 1: pending, 2: started, 3: success, 4: failure, 5: cancelled
+	Error         JobError        `json:"error,omitempty"`
+	QueryResultID int             `json:"query_result_id,omitempty"`
+	QueryResult   *QueryResult    `json:"query_result,omitempty"`
+	Result        json.RawMessage `json:"result,omitempty"` // クエリ以外のジョブ（スキーマ取得など）の結果
+}
+
+// jobResponse は /api/jobs/:id のレスポンス {"job": {...}}
+type jobResponse struct {
+	Job QueryJob `json:"job"`
 }
 
 type QueryResult struct {
-	ID   int             `json:"id"`
-	Data json.RawMessage `json:"data"`
+	ID           int             `json:"id"`
+	Query        string          `json:"query,omitempty"`
+	DataSourceID int             `json:"data_source_id,omitempty"`
+	Runtime      float64         `json:"runtime,omitempty"`
+	RetrievedAt  string          `json:"retrieved_at,omitempty"`
+	Data         json.RawMessage `json:"data"`
 }
 
 // QueryResultData はクエリ結果のデータ部分
@@ -73,70 +84,210 @@ type Column struct {
 
 // Query はクエリのメタデータ
 type Query struct {
-	ID           int    `json:"id"`
-	Name         string `json:"name"`
-	Description  string `json:"description"`
-	Query        string `json:"query"`
-	DataSourceID int    `json:"data_source_id"`
-	CreatedAt    string `json:"created_at"`
-	UpdatedAt    string `json:"updated_at"`
+	ID                int                    `json:"id"`
+	Name              string                 `json:"name"`
+	Description       string                 `json:"description"`
+	Query             string                 `json:"query"`
+	DataSourceID      int                    `json:"data_source_id"`
+	Tags              []string               `json:"tags"`
+	Options           map[string]interface{} `json:"options,omitempty"`
+	IsDraft           bool                   `json:"is_draft"`
+	IsArchived        bool                   `json:"is_archived"`
+	Version           int                    `json:"version"`
+	LatestQueryDataID *int                   `json:"latest_query_data_id"`       // 最新のキャッシュ済み結果の ID
+	User              *User                  `json:"user,omitempty"`             // 作成者（所有者）
+	LastModifiedBy    *User                  `json:"last_modified_by,omitempty"` // 最終更新者
+	Schedule          *QuerySchedule         `json:"schedule"`                   // 定期実行のスケジュール（nil の場合は定期実行しない）
+	Visualizations    []Visualization        `json:"visualizations,omitempty"`
+	CreatedAt         string                 `json:"created_at"`
+	UpdatedAt         string                 `json:"updated_at"`
 }
 
 // Dashboard はダッシュボードのメタデータ
 type Dashboard struct {
-	ID        int       `json:"id"`
-	Name      string    `json:"name"`
-	Slug      string    `json:"slug"`
-	Widgets   []Widget  `json:"widgets"`
-	CreatedAt string    `json:"created_at"`
-	UpdatedAt string    `json:"updated_at"`
+	ID         int      `json:"id"`
+	Name       string   `json:"name"`
+	Slug       string   `json:"slug"`
+	Tags       []string `json:"tags"`
+	Widgets    []Widget `json:"widgets"`
+	IsDraft    bool     `json:"is_draft"`
+	IsArchived bool     `json:"is_archived"`
+	IsFavorite bool     `json:"is_favorite"`
+	User       *User    `json:"user,omitempty"` // 作成者（所有者）
+	Version    int      `json:"version"`
+	CreatedAt  string   `json:"created_at"`
+	UpdatedAt  string   `json:"updated_at"`
 }
 
 // Widget はダッシュボード内のウィジェット
+// ビジュアライゼーションを表示するウィジェットと、テキストのみのウィジェットがある
 type Widget struct {
-	ID            int            `json:"id"`
-	Visualization *Visualization `json:"visualization,omitempty"`
+	ID            int                    `json:"id"`
+	DashboardID   int                    `json:"dashboard_id"`
+	Text          string                 `json:"text"`
+	Width         int                    `json:"width"`
+	Options       WidgetOptions          `json:"options"`
+	RawOptions    map[string]interface{} `json:"-"` // WidgetOptions に含まれないキーも含む options（更新時に使う）
+	Visualization *Visualization         `json:"visualization,omitempty"`
+}
+
+// WidgetOptions はウィジェットの表示オプション
+type WidgetOptions struct {
+	IsHidden          bool                              `json:"isHidden,omitempty"`
+	Position          *WidgetPosition                   `json:"position,omitempty"`
+	ParameterMappings map[string]WidgetParameterMapping `json:"parameterMappings,omitempty"`
+}
+
+// WidgetPosition はダッシュボードのグリッド（横6列）上でのウィジェットの位置とサイズ
+type WidgetPosition struct {
+	Col        int  `json:"col"`
+	Row        int  `json:"row"`
+	SizeX      int  `json:"sizeX"`
+	SizeY      int  `json:"sizeY"`
+	AutoHeight bool `json:"autoHeight"`
+	MinSizeX   int  `json:"minSizeX,omitempty"`
+	MaxSizeX   int  `json:"maxSizeX,omitempty"`
+	MinSizeY   int  `json:"minSizeY,omitempty"`
+	MaxSizeY   int  `json:"maxSizeY,omitempty"`
+}
+
+// パラメータの値の取得元（WidgetParameterMapping の Type）
+const (
+	ParameterMappingDashboardLevel = "dashboard-level" // ダッシュボードのパラメータ（MapTo）の値を使う
+	ParameterMappingWidgetLevel    = "widget-level"    // ウィジェットごとの値（クエリのデフォルト値）を使う
+	ParameterMappingStaticValue    = "static-value"    // Value の固定値を使う
+	ParameterMappingUnused         = "unused"
+)
+
+// WidgetParameterMapping はクエリパラメータの値の取得元
+// Type: "dashboard-level", "widget-level", "static-value", "unused"
+type WidgetParameterMapping struct {
+	Name  string      `json:"name"`
+	Type  string      `json:"type"`
+	MapTo string      `json:"mapTo"`
+	Value interface{} `json:"value"`
+	Title string      `json:"title"`
 }
 
 // Visualization はウィジェット内のビジュアライゼーション
+// Options の内容は Type によって異なる（visualizations.go の各オプション型を参照）
 type Visualization struct {
-	ID    int          `json:"id"`
-	Name  string       `json:"name"`
-	Type  string       `json:"type"`
-	Query *WidgetQuery `json:"query,omitempty"`
+	ID          int             `json:"id"`
+	Name        string          `json:"name"`
+	Type        string          `json:"type"`
+	Description string          `json:"description,omitempty"`
+	Options     json.RawMessage `json:"options,omitempty"`
+	Query       *WidgetQuery    `json:"query,omitempty"`
 }
 
 // WidgetQuery はビジュアライゼーションに紐づくクエリ
 type WidgetQuery struct {
-	ID           int    `json:"id"`
-	Name         string `json:"name"`
-	Description  string `json:"description"`
-	Query        string `json:"query"`
-	DataSourceID int    `json:"data_source_id"`
+	ID           int                    `json:"id"`
+	Name         string                 `json:"name"`
+	Description  string                 `json:"description"`
+	Query        string                 `json:"query"`
+	DataSourceID int                    `json:"data_source_id"`
+	Options      map[string]interface{} `json:"options,omitempty"`
+}
+
+// User は Redash のユーザー
+type User struct {
+	ID                  int         `json:"id"`
+	Name                string      `json:"name"`
+	Email               string      `json:"email"`
+	Groups              []UserGroup `json:"groups,omitempty"`
+	IsDisabled          bool        `json:"is_disabled,omitempty"`
+	IsInvitationPending bool        `json:"is_invitation_pending,omitempty"`
+	ActiveAt            string      `json:"active_at,omitempty"`
+	CreatedAt           string      `json:"created_at,omitempty"`
 }
 
 // Alert はアラートのメタデータ
 type Alert struct {
-	ID        int                    `json:"id"`
-	Name      string                 `json:"name"`
-	Query     *Query                 `json:"query,omitempty"`
-	State     string                 `json:"state"`
-	Options   map[string]interface{} `json:"options"`
-	CreatedAt string                 `json:"created_at"`
-	UpdatedAt string                 `json:"updated_at"`
+	ID              int                    `json:"id"`
+	Name            string                 `json:"name"`
+	Query           *Query                 `json:"query,omitempty"`
+	State           string                 `json:"state"` // "ok", "triggered", "unknown"
+	Options         AlertOptions           `json:"options"`
+	RawOptions      map[string]interface{} `json:"-"`     // AlertOptions に含まれないキーも含む options（更新時に使う）
+	Rearm           *int                   `json:"rearm"` // 再通知までの秒数（nil の場合は一度だけ通知）
+	LastTriggeredAt string                 `json:"last_triggered_at,omitempty"`
+	CreatedAt       string                 `json:"created_at"`
+	UpdatedAt       string                 `json:"updated_at"`
+}
+
+// AlertOptions はアラートの条件と通知内容
+// クエリ結果の1行目の Column の値を Op で Value と比較する
+type AlertOptions struct {
+	Column        string      `json:"column"`
+	Op            string      `json:"op"` // ">", ">=", "<", "<=", "==", "!="
+	Value         interface{} `json:"value"`
+	CustomSubject string      `json:"custom_subject,omitempty"`
+	CustomBody    string      `json:"custom_body,omitempty"`
+	Muted         bool        `json:"muted,omitempty"`
+}
+
+// クエリ結果の取得元
+const (
+	QueryResultSourceCache = "cache" // Redash にキャッシュされていた結果
+	QueryResultSourceJob   = "job"   // 新規に実行したジョブの結果
+)
+
+// QueryExecution は保存済みクエリの実行結果と、その取得元
+type QueryExecution struct {
+	Source string          // QueryResultSourceCache または QueryResultSourceJob
+	JobID  string          // Source が job の場合のジョブID
+	Data   json.RawMessage // クエリ結果のデータ
 }
 
 // ExecuteQuery は保存済みクエリを実行
 // query_id: 実行するクエリのID
 // parameters: クエリパラメータ（オプション）
-func (c *Client) ExecuteQuery(queryID int, parameters map[string]interface{}) (json.RawMessage, error) {
+// maxAge: キャッシュを許容する秒数（nil の場合は Redash のデフォルト、0 の場合は常に再実行）
+func (c *Client) ExecuteQuery(queryID int, parameters map[string]interface{}, maxAge *int) (*QueryExecution, error) {
+	result, err := c.StartQuery(queryID, parameters, maxAge)
+	if err != nil {
+		return nil, err
+	}
+
+	// パターン1: キャッシュがある場合は直接結果を返す
+	if result.QueryResult != nil {
+		return &QueryExecution{
+			Source: QueryResultSourceCache,
+			Data:   result.QueryResult.Data,
+		}, nil
+	}
+
+	// パターン2: ジョブの完了を待つ
+	if result.Job != nil {
+		data, err := c.waitForJob(result.Job.ID)
+		if err != nil {
+			return nil, err
+		}
+		return &QueryExecution{
+			Source: QueryResultSourceJob,
+			JobID:  result.Job.ID,
+			Data:   data,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("unexpected response format: no query_result or job found")
+}
+
+// StartQuery は保存済みクエリの実行を開始し、ジョブの完了を待たずに返す
+// キャッシュがある場合は QueryResult、新規実行の場合は Job が設定される
+func (c *Client) StartQuery(queryID int, parameters map[string]interface{}, maxAge *int) (*QueryExecuteResponse, error) {
 	url := fmt.Sprintf("%s/api/queries/%d/results", c.BaseURL, queryID)
 
-	// パラメータがある場合は JSON エンコード
+	// パラメータや max_age がある場合は JSON エンコード
 	var body io.Reader
-	if len(parameters) > 0 {
-		paramsJSON := map[string]interface{}{
-			"parameters": parameters,
+	if len(parameters) > 0 || maxAge != nil {
+		paramsJSON := map[string]interface{}{}
+		if len(parameters) > 0 {
+			paramsJSON["parameters"] = parameters
+		}
+		if maxAge != nil {
+			paramsJSON["max_age"] = *maxAge
 		}
 		data, err := json.Marshal(paramsJSON)
 		if err != nil {
@@ -170,6 +321,23 @@ func (c *Client) ExecuteQuery(queryID int, parameters map[string]interface{}) (j
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
+	if result.QueryResult == nil && result.Job == nil {
+		return nil, fmt.Errorf("unexpected response format: no query_result or job found")
+	}
+
+	return &result, nil
+}
+
+// ExecuteAdhocQuery はアドホッククエリを実行
+// query: 実行する SQL
+// dataSourceID: データソースID
+// parameters: {{ name }} に展開する値（オプション、RenderAdhocParameters で変換済みのもの）
+func (c *Client) ExecuteAdhocQuery(query string, dataSourceID int, parameters map[string]interface{}) (json.RawMessage, error) {
+	result, err := c.StartAdhocQuery(query, dataSourceID, parameters)
+	if err != nil {
+		return nil, err
+	}
+
 	// パターン1: キャッシュがある場合は直接結果を返す
 	if result.QueryResult != nil {
 		return result.QueryResult.Data, nil
@@ -183,16 +351,18 @@ func (c *Client) ExecuteQuery(queryID int, parameters map[string]interface{}) (j
 	return nil, fmt.Errorf("unexpected response format: no query_result or job found")
 }
 
-// ExecuteAdhocQuery はアドホッククエリを実行
-// query: 実行する SQL
-// dataSourceID: データソースID
-func (c *Client) ExecuteAdhocQuery(query string, dataSourceID int) (json.RawMessage, error) {
+// StartAdhocQuery はアドホッククエリの実行を開始し、ジョブの完了を待たずに返す
+// キャッシュがある場合は QueryResult、新規実行の場合は Job が設定される
+func (c *Client) StartAdhocQuery(query string, dataSourceID int, parameters map[string]interface{}) (*QueryExecuteResponse, error) {
 	url := fmt.Sprintf("%s/api/query_results", c.BaseURL)
 
 	reqBody := map[string]interface{}{
 		"query":          query,
 		"data_source_id": dataSourceID,
 	}
+	if len(parameters) > 0 {
+		reqBody["parameters"] = parameters
+	}
 
 	data, err := json.Marshal(reqBody)
 	if err != nil {
@@ -224,60 +394,67 @@ func (c *Client) ExecuteAdhocQuery(query string, dataSourceID int) (json.RawMess
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	// パターン1: キャッシュがある場合は直接結果を返す
-	if result.QueryResult != nil {
-		return result.QueryResult.Data, nil
+	if result.QueryResult == nil && result.Job == nil {
+		return nil, fmt.Errorf("unexpected response format: no query_result or job found")
 	}
 
-	// パターン2: ジョブの完了を待つ
-	if result.Job != nil {
-		return c.waitForJob(result.Job.ID)
-	}
-
-	return nil, fmt.Errorf("unexpected response format: no query_result or job found")
+	return &result, nil
 }
 
-// waitForJob はジョブの完了を待機してクエリ結果を返す
+// waitForJob はジョブの完了を最大30秒待機してクエリ結果を返す
 func (c *Client) waitForJob(jobID string) (json.RawMessage, error) {
-	url := fmt.Sprintf("%s/api/jobs/%s", c.BaseURL, jobID)
+	return c.WaitForJob(jobID, 30*time.Second)
+}
 
-	// 最大30回、1秒間隔でポーリング
-	for i := 0; i < 30; i++ {
-		time.Sleep(1 * time.Second)
+// WaitForJob はジョブの完了を timeout まで待機してクエリ結果を返す
+// タイムアウトしてもジョブは Redash 側で実行され続けるため、不要なら CancelJob で停止する
+func (c *Client) WaitForJob(jobID string, timeout time.Duration) (json.RawMessage, error) {
+	job, err := c.waitForJobSuccess(jobID, timeout)
+	if err != nil {
+		return nil, err
+	}
 
-		req, err := http.NewRequest("GET", url, nil)
+	if job.QueryResult != nil {
+		return job.QueryResult.Data, nil
+	}
+	// 完了したジョブは結果の ID のみを返すため、結果を取得する
+	if job.QueryResultID != 0 {
+		queryResult, err := c.GetQueryResult(job.QueryResultID)
 		if err != nil {
-			return nil, fmt.Errorf("failed to create job status request: %w", err)
+			return nil, err
 		}
+		return queryResult.Data, nil
+	}
+	return nil, fmt.Errorf("query succeeded but no result data")
+}
 
-		req.Header.Set("Authorization", fmt.Sprintf("Key %s", c.APIKey))
+// waitForJobSuccess はジョブが成功するまで timeout まで待機して完了したジョブを返す
+// 失敗・キャンセル・タイムアウトの場合はエラーを返す
+func (c *Client) waitForJobSuccess(jobID string, timeout time.Duration) (*QueryJob, error) {
+	deadline := time.Now().Add(timeout)
 
-		resp, err := c.client.Do(req)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get job status: %w", err)
-		}
+	// 1秒間隔でポーリング
+	for time.Now().Before(deadline) {
+		time.Sleep(1 * time.Second)
 
-		var job QueryJob
-		if err := json.NewDecoder(resp.Body).Decode(&job); err != nil {
-			resp.Body.Close()
-			return nil, fmt.Errorf("failed to decode job status: %w", err)
+		job, err := c.GetJob(jobID)
+		if err != nil {
+			return nil, err
 		}
-		resp.Body.Close()
 
 		switch job.Status {
-		case 3: // Success
-			if job.QueryResult != nil {
-				return job.QueryResult.Data, nil
-			}
-			return nil, fmt.Errorf("query succeeded but no result data")
-		case 4: // Failure
-			return nil, fmt.Errorf("query failed: %s", job.Error)
-		case 1, 2: // Pending or Started
+		case JobStatusSuccess:
+			return job, nil
+		case JobStatusFailure:
+			return nil, fmt.Errorf("job %s failed: %s", jobID, job.Error)
+		case JobStatusCancelled:
+			return nil, fmt.Errorf("job %s was cancelled", jobID)
+		case JobStatusPending, JobStatusStarted:
 			continue
 		}
 	}
 
-	return nil, fmt.Errorf("query timeout: job did not complete in 30 seconds")
+	return nil, fmt.Errorf("timeout: job %s did not complete in %s", jobID, timeout)
 }
 
 // GetQuery はクエリのメタデータを取得
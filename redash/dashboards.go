@@ -0,0 +1,389 @@
+package redash
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// DashboardSummary はダッシュボード一覧に含まれるダッシュボードの要約
+type DashboardSummary struct {
+	ID               int      `json:"id"`
+	Name             string   `json:"name"`
+	Slug             string   `json:"slug"`
+	Tags             []string `json:"tags"`
+	IsDraft          bool     `json:"is_draft"`
+	IsArchived       bool     `json:"is_archived"`
+	IsFavorite       bool     `json:"is_favorite"`
+	WidgetCount      *int     `json:"widget_count,omitempty"`       // 一覧 API は返さないため、必要に応じて呼び出し側で設定
+	WidgetCountError string   `json:"widget_count_error,omitempty"` // ウィジェット数を取得できなかった場合のエラー
+	CreatedAt        string   `json:"created_at"`
+	UpdatedAt        string   `json:"updated_at"`
+}
+
+// DashboardList はページネーション付きのダッシュボード一覧
+type DashboardList struct {
+	Count    int                `json:"count"`
+	Page     int                `json:"page"`
+	PageSize int                `json:"page_size"`
+	Results  []DashboardSummary `json:"results"`
+}
+
+// DashboardListOptions はダッシュボード一覧の絞り込み条件
+type DashboardListOptions struct {
+	Page          int      // ページ番号（1始まり、0 の場合は Redash のデフォルト）
+	PageSize      int      // 1ページあたりの件数（0 の場合は Redash のデフォルト）
+	Search        string   // 名前に対するテキスト検索
+	Tags          []string // タグによる絞り込み（すべてのタグを持つダッシュボードのみ）
+	FavoritesOnly bool     // お気に入りのダッシュボードのみ
+}
+
+// values はクエリ文字列に変換
+func (o DashboardListOptions) values() url.Values {
+	params := url.Values{}
+	if o.Page > 0 {
+		params.Set("page", strconv.Itoa(o.Page))
+	}
+	if o.PageSize > 0 {
+		params.Set("page_size", strconv.Itoa(o.PageSize))
+	}
+	if o.Search != "" {
+		params.Set("q", o.Search)
+	}
+	for _, tag := range o.Tags {
+		params.Add("tags", tag)
+	}
+	return params
+}
+
+// CreateWidgetInput はウィジェット作成時の入力
+// テキストウィジェットの場合は VisualizationID を nil にして Text を指定する
+type CreateWidgetInput struct {
+	DashboardID     int           `json:"dashboard_id"`
+	VisualizationID *int          `json:"visualization_id"`
+	Text            string        `json:"text"`
+	Width           int           `json:"width"`
+	Options         WidgetOptions `json:"options"`
+}
+
+// UpdateWidgetInput はウィジェット更新時の入力
+// Redash は text と options の両方を必須とし、options を丸ごと置き換えるため、
+// Options は現在の Widget.RawOptions に変更をマージして渡す
+type UpdateWidgetInput struct {
+	Text    string                 `json:"text"`
+	Options map[string]interface{} `json:"options"`
+}
+
+// UnmarshalJSON はウィジェットを読み込み、options を RawOptions にもそのまま保持する
+func (w *Widget) UnmarshalJSON(data []byte) error {
+	type widget Widget
+	var decoded widget
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+
+	var raw struct {
+		Options map[string]interface{} `json:"options"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	*w = Widget(decoded)
+	w.RawOptions = raw.Options
+	return nil
+}
+
+// ListDashboards はダッシュボードの一覧を取得
+func (c *Client) ListDashboards(opts DashboardListOptions) (*DashboardList, error) {
+	path := "/api/dashboards"
+	if opts.FavoritesOnly {
+		path = "/api/dashboards/favorites"
+	}
+	url := fmt.Sprintf("%s%s?%s", c.BaseURL, path, opts.values().Encode())
+
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Key %s", c.APIKey))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	var list DashboardList
+	if err := json.NewDecoder(resp.Body).Decode(&list); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &list, nil
+}
+
+// UpdateDashboardInput はダッシュボード更新時の入力
+// nil のフィールドは送信せず、Redash 側の値を変更しない
+type UpdateDashboardInput struct {
+	Name       *string   `json:"name,omitempty"`
+	Tags       *[]string `json:"tags,omitempty"`
+	IsDraft    *bool     `json:"is_draft,omitempty"`
+	IsArchived *bool     `json:"is_archived,omitempty"`
+}
+
+// UpdateDashboard は既存のダッシュボードを更新
+func (c *Client) UpdateDashboard(dashboardID int, input UpdateDashboardInput) (*Dashboard, error) {
+	url := fmt.Sprintf("%s/api/dashboards/%d", c.BaseURL, dashboardID)
+
+	data, err := json.Marshal(input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequest("POST", url, bytes.NewBuffer(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Key %s", c.APIKey))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	var dashboard Dashboard
+	if err := json.NewDecoder(resp.Body).Decode(&dashboard); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &dashboard, nil
+}
+
+// ArchiveDashboard はダッシュボードをアーカイブ
+// Redash の削除 API はダッシュボードを slug で指定する
+func (c *Client) ArchiveDashboard(dashboardSlug string) error {
+	url := fmt.Sprintf("%s/api/dashboards/%s", c.BaseURL, dashboardSlug)
+
+	req, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Key %s", c.APIKey))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	return nil
+}
+
+// UnarchiveDashboard はアーカイブ済みのダッシュボードを元に戻す
+func (c *Client) UnarchiveDashboard(dashboardID int) (*Dashboard, error) {
+	isArchived := false
+	return c.UpdateDashboard(dashboardID, UpdateDashboardInput{IsArchived: &isArchived})
+}
+
+// CreateDashboard は新しいダッシュボードを作成
+// Redash では作成直後のダッシュボードは下書き（is_draft）になる
+func (c *Client) CreateDashboard(name string) (*Dashboard, error) {
+	url := fmt.Sprintf("%s/api/dashboards", c.BaseURL)
+
+	reqBody := map[string]interface{}{
+		"name": name,
+	}
+
+	data, err := json.Marshal(reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequest("POST", url, bytes.NewBuffer(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Key %s", c.APIKey))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	var dashboard Dashboard
+	if err := json.NewDecoder(resp.Body).Decode(&dashboard); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &dashboard, nil
+}
+
+// CreateWidget はダッシュボードにウィジェットを追加
+func (c *Client) CreateWidget(input CreateWidgetInput) (*Widget, error) {
+	url := fmt.Sprintf("%s/api/widgets", c.BaseURL)
+
+	data, err := json.Marshal(input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequest("POST", url, bytes.NewBuffer(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Key %s", c.APIKey))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	var widget Widget
+	if err := json.NewDecoder(resp.Body).Decode(&widget); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &widget, nil
+}
+
+// UpdateWidget はウィジェットのテキストや位置を更新
+func (c *Client) UpdateWidget(widgetID int, input UpdateWidgetInput) (*Widget, error) {
+	url := fmt.Sprintf("%s/api/widgets/%d", c.BaseURL, widgetID)
+
+	data, err := json.Marshal(input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequest("POST", url, bytes.NewBuffer(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Key %s", c.APIKey))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	var widget Widget
+	if err := json.NewDecoder(resp.Body).Decode(&widget); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &widget, nil
+}
+
+// DeleteWidget はダッシュボードからウィジェットを削除
+func (c *Client) DeleteWidget(widgetID int) error {
+	url := fmt.Sprintf("%s/api/widgets/%d", c.BaseURL, widgetID)
+
+	req, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Key %s", c.APIKey))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	return nil
+}
+
+// WidgetParameterValues はウィジェットの parameterMappings に沿って、ダッシュボードのパラメータを
+// ウィジェットのクエリのパラメータに割り当てる
+// 値が決まらないパラメータは含めないため、ValidateQueryParameters でデフォルト値を補う
+// 戻り値の used は割り当てに使われたダッシュボードのパラメータ名
+func WidgetParameterValues(widget Widget, definitions []QueryParameter, dashboardParams map[string]interface{}) (values map[string]interface{}, used []string) {
+	values = make(map[string]interface{})
+	for _, definition := range definitions {
+		// マッピングがない場合、global なパラメータは同じ名前のダッシュボードのパラメータを使い、
+		// それ以外はウィジェットごとの値（クエリのデフォルト値）を使う（Redash のフロントエンドと同じ）
+		mapping, ok := widget.Options.ParameterMappings[definition.Name]
+		if !ok {
+			mapping = WidgetParameterMapping{
+				Name: definition.Name,
+				Type: ParameterMappingWidgetLevel,
+			}
+			if definition.Global {
+				mapping.Type = ParameterMappingDashboardLevel
+				mapping.MapTo = definition.Name
+			}
+		}
+
+		switch mapping.Type {
+		case ParameterMappingDashboardLevel:
+			mapTo := mapping.MapTo
+			if mapTo == "" {
+				mapTo = definition.Name
+			}
+			if value, ok := dashboardParams[mapTo]; ok {
+				values[definition.Name] = value
+				used = append(used, mapTo)
+			}
+		case ParameterMappingStaticValue:
+			if mapping.Value != nil {
+				values[definition.Name] = mapping.Value
+			}
+		}
+	}
+
+	return values, used
+}
@@ -0,0 +1,84 @@
+package redash
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// DataSource はデータソースのメタデータ
+// 接続情報（options）は秘匿情報を含むため保持しない
+type DataSource struct {
+	ID                 int             `json:"id"`
+	Name               string          `json:"name"`
+	Type               string          `json:"type"`
+	Syntax             string          `json:"syntax"`
+	Paused             bool            `json:"paused"`
+	PauseReason        string          `json:"pause_reason,omitempty"`
+	ViewOnly           bool            `json:"view_only"`
+	QueueName          string          `json:"queue_name,omitempty"`           // GetDataSource のみ
+	ScheduledQueueName string          `json:"scheduled_queue_name,omitempty"` // GetDataSource のみ
+	Groups             map[string]bool `json:"groups,omitempty"`               // アクセスできるグループID → 閲覧のみか（GetDataSource のみ）
+}
+
+// ListDataSources はデータソースの一覧を取得
+func (c *Client) ListDataSources() ([]DataSource, error) {
+	url := fmt.Sprintf("%s/api/data_sources", c.BaseURL)
+
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Key %s", c.APIKey))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	var dataSources []DataSource
+	if err := json.NewDecoder(resp.Body).Decode(&dataSources); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return dataSources, nil
+}
+
+// GetDataSource はデータソースのメタデータを取得（管理者のみ）
+// 一覧には含まれないキュー名・グループも返す
+func (c *Client) GetDataSource(dataSourceID int) (*DataSource, error) {
+	url := fmt.Sprintf("%s/api/data_sources/%d", c.BaseURL, dataSourceID)
+
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Key %s", c.APIKey))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	var dataSource DataSource
+	if err := json.NewDecoder(resp.Body).Decode(&dataSource); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &dataSource, nil
+}
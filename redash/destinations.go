@@ -0,0 +1,234 @@
+package redash
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// Destination はアラートの通知先（Slack、Email、Webhook など）
+type Destination struct {
+	ID      int                    `json:"id"`
+	Name    string                 `json:"name"`
+	Type    string                 `json:"type"`
+	Icon    string                 `json:"icon,omitempty"`
+	Options map[string]interface{} `json:"options,omitempty"`
+}
+
+// DestinationType は作成可能な通知先の種類
+type DestinationType struct {
+	Name                string          `json:"name"`
+	Type                string          `json:"type"`
+	ConfigurationSchema json.RawMessage `json:"configuration_schema"`
+}
+
+// CreateDestinationInput は通知先作成時の入力
+type CreateDestinationInput struct {
+	Name    string                 `json:"name"`
+	Type    string                 `json:"type"`
+	Options map[string]interface{} `json:"options"`
+}
+
+// AlertSubscription はアラートの購読
+// Destination が nil の場合はユーザー本人へのメール通知
+type AlertSubscription struct {
+	ID          int          `json:"id"`
+	AlertID     int          `json:"alert_id"`
+	User        *User        `json:"user,omitempty"`
+	Destination *Destination `json:"destination,omitempty"`
+}
+
+// ListDestinations は通知先の一覧を取得
+func (c *Client) ListDestinations() ([]Destination, error) {
+	url := fmt.Sprintf("%s/api/destinations", c.BaseURL)
+
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Key %s", c.APIKey))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	var destinations []Destination
+	if err := json.NewDecoder(resp.Body).Decode(&destinations); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return destinations, nil
+}
+
+// ListDestinationTypes は作成可能な通知先の種類と設定項目を取得
+func (c *Client) ListDestinationTypes() ([]DestinationType, error) {
+	url := fmt.Sprintf("%s/api/destinations/types", c.BaseURL)
+
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Key %s", c.APIKey))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	var types []DestinationType
+	if err := json.NewDecoder(resp.Body).Decode(&types); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return types, nil
+}
+
+// CreateDestination は新しい通知先を作成（管理者権限が必要）
+func (c *Client) CreateDestination(input CreateDestinationInput) (*Destination, error) {
+	url := fmt.Sprintf("%s/api/destinations", c.BaseURL)
+
+	data, err := json.Marshal(input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequest("POST", url, bytes.NewBuffer(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Key %s", c.APIKey))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	var destination Destination
+	if err := json.NewDecoder(resp.Body).Decode(&destination); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &destination, nil
+}
+
+// ListAlertSubscriptions はアラートの購読一覧を取得
+func (c *Client) ListAlertSubscriptions(alertID int) ([]AlertSubscription, error) {
+	url := fmt.Sprintf("%s/api/alerts/%d/subscriptions", c.BaseURL, alertID)
+
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Key %s", c.APIKey))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	var subscriptions []AlertSubscription
+	if err := json.NewDecoder(resp.Body).Decode(&subscriptions); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return subscriptions, nil
+}
+
+// AddAlertSubscription はアラートに購読を追加
+// destinationID が nil の場合は API キーのユーザー本人へのメール通知になる
+func (c *Client) AddAlertSubscription(alertID int, destinationID *int) (*AlertSubscription, error) {
+	url := fmt.Sprintf("%s/api/alerts/%d/subscriptions", c.BaseURL, alertID)
+
+	reqBody := map[string]interface{}{}
+	if destinationID != nil {
+		reqBody["destination_id"] = *destinationID
+	}
+
+	data, err := json.Marshal(reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequest("POST", url, bytes.NewBuffer(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Key %s", c.APIKey))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	var subscription AlertSubscription
+	if err := json.NewDecoder(resp.Body).Decode(&subscription); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &subscription, nil
+}
+
+// RemoveAlertSubscription はアラートから購読を削除
+func (c *Client) RemoveAlertSubscription(alertID, subscriptionID int) error {
+	url := fmt.Sprintf("%s/api/alerts/%d/subscriptions/%d", c.BaseURL, alertID, subscriptionID)
+
+	req, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Key %s", c.APIKey))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	return nil
+}
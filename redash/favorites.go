@@ -0,0 +1,69 @@
+package redash
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// SetQueryFavorite はクエリをお気に入りに追加・削除
+// お気に入りは API キーのユーザーごとに管理される
+func (c *Client) SetQueryFavorite(queryID int, favorite bool) error {
+	url := fmt.Sprintf("%s/api/queries/%d/favorite", c.BaseURL, queryID)
+
+	method := "POST"
+	if !favorite {
+		method = "DELETE"
+	}
+
+	req, err := http.NewRequest(method, url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Key %s", c.APIKey))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	return nil
+}
+
+// SetDashboardFavorite はダッシュボードをお気に入りに追加・削除
+// Redash のお気に入り API はダッシュボードを slug で指定する
+func (c *Client) SetDashboardFavorite(dashboardSlug string, favorite bool) error {
+	url := fmt.Sprintf("%s/api/dashboards/%s/favorite", c.BaseURL, dashboardSlug)
+
+	method := "POST"
+	if !favorite {
+		method = "DELETE"
+	}
+
+	req, err := http.NewRequest(method, url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Key %s", c.APIKey))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	return nil
+}
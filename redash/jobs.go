@@ -0,0 +1,138 @@
+package redash
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// ジョブのステータス
+const (
+	JobStatusPending   = 1
+	JobStatusStarted   = 2
+	JobStatusSuccess   = 3
+	JobStatusFailure   = 4
+	JobStatusCancelled = 5
+)
+
+// JobStatusName はジョブのステータスを名前に変換
+func JobStatusName(status int) string {
+	switch status {
+	case JobStatusPending:
+		return "pending"
+	case JobStatusStarted:
+		return "started"
+	case JobStatusSuccess:
+		return "success"
+	case JobStatusFailure:
+		return "failure"
+	case JobStatusCancelled:
+		return "cancelled"
+	default:
+		return "unknown"
+	}
+}
+
+// JobError はジョブのエラー
+// 通常は文字列だが、ジョブの種類によってはオブジェクトが返るため JSON のまま文字列として保持する
+type JobError string
+
+// UnmarshalJSON はエラーを文字列またはそれ以外の JSON 値のどちらからでも読み込む
+func (e *JobError) UnmarshalJSON(data []byte) error {
+	var message string
+	if err := json.Unmarshal(data, &message); err == nil {
+		*e = JobError(message)
+		return nil
+	}
+
+	var value map[string]interface{}
+	if err := json.Unmarshal(data, &value); err == nil {
+		if message, ok := value["message"].(string); ok {
+			*e = JobError(message)
+			return nil
+		}
+	}
+	if string(data) == "null" {
+		*e = ""
+		return nil
+	}
+	*e = JobError(data)
+	return nil
+}
+
+// UnmarshalJSON はジョブを読み込む
+// Redash は query_result_id と result に同じ値（ジョブの戻り値）を設定するため、クエリ実行ジョブでは
+// 結果の ID、スキーマ取得ジョブではテーブルの配列になる。query_result_id は数値の場合のみ設定する
+func (j *QueryJob) UnmarshalJSON(data []byte) error {
+	type queryJob QueryJob
+	var job struct {
+		queryJob
+		QueryResultID json.RawMessage `json:"query_result_id,omitempty"`
+	}
+	if err := json.Unmarshal(data, &job); err != nil {
+		return err
+	}
+
+	*j = QueryJob(job.queryJob)
+	var queryResultID int
+	if err := json.Unmarshal(job.QueryResultID, &queryResultID); err == nil {
+		j.QueryResultID = queryResultID
+	}
+	return nil
+}
+
+// GetJob はクエリ実行ジョブの状態を取得
+func (c *Client) GetJob(jobID string) (*QueryJob, error) {
+	url := fmt.Sprintf("%s/api/jobs/%s", c.BaseURL, jobID)
+
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create job status request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Key %s", c.APIKey))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get job status: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	var result jobResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode job status: %w", err)
+	}
+
+	return &result.Job, nil
+}
+
+// CancelJob は実行中のクエリジョブをキャンセル
+func (c *Client) CancelJob(jobID string) error {
+	url := fmt.Sprintf("%s/api/jobs/%s", c.BaseURL, jobID)
+
+	req, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Key %s", c.APIKey))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	return nil
+}
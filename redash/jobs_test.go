@@ -0,0 +1,112 @@
+package redash
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestJobErrorUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want JobError
+	}{
+		{name: "string", data: `"relation \"users\" does not exist"`, want: `relation "users" does not exist`},
+		{name: "message object", data: `{"code": 2, "message": "Error retrieving schema."}`, want: "Error retrieving schema."},
+		{name: "object without message", data: `{"code": 2}`, want: `{"code": 2}`},
+		{name: "null", data: `null`, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got JobError
+			if err := json.Unmarshal([]byte(tt.data), &got); err != nil {
+				t.Fatalf("Unmarshal() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Unmarshal() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetJob(t *testing.T) {
+	tests := []struct {
+		name              string
+		job               string
+		wantStatus        int
+		wantError         JobError
+		wantQueryResultID int
+		wantResult        string
+	}{
+		{
+			name:              "finished query job",
+			job:               `{"id": "job", "updated_at": 0, "status": 3, "error": "", "result": 42, "query_result_id": 42}`,
+			wantStatus:        JobStatusSuccess,
+			wantQueryResultID: 42,
+			wantResult:        `42`,
+		},
+		{
+			name:       "running query job",
+			job:        `{"id": "job", "updated_at": 0, "status": 2, "error": null, "result": null, "query_result_id": null}`,
+			wantStatus: JobStatusStarted,
+			wantResult: `null`,
+		},
+		{
+			name:       "failed query job",
+			job:        `{"id": "job", "updated_at": 0, "status": 4, "error": "syntax error", "result": null, "query_result_id": null}`,
+			wantStatus: JobStatusFailure,
+			wantError:  "syntax error",
+			wantResult: `null`,
+		},
+		{
+			name:       "finished schema job",
+			job:        `{"id": "job", "updated_at": 0, "status": 3, "error": null, "result": [{"name": "users", "columns": ["id"]}], "query_result_id": [{"name": "users", "columns": ["id"]}]}`,
+			wantStatus: JobStatusSuccess,
+			wantResult: `[{"name": "users", "columns": ["id"]}]`,
+		},
+		{
+			name:       "failed schema job",
+			job:        `{"id": "job", "updated_at": 0, "status": 4, "error": {"code": 2, "message": "Error retrieving schema."}, "result": null, "query_result_id": null}`,
+			wantStatus: JobStatusFailure,
+			wantError:  "Error retrieving schema.",
+			wantResult: `null`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/api/jobs/job" {
+					http.NotFound(w, r)
+					return
+				}
+				fmt.Fprintf(w, `{"job": %s}`, tt.job)
+			}))
+			defer srv.Close()
+
+			job, err := NewClient(srv.URL, "key", true).GetJob("job")
+			if err != nil {
+				t.Fatalf("GetJob() error = %v", err)
+			}
+			if job.ID != "job" {
+				t.Errorf("ID = %q, want %q", job.ID, "job")
+			}
+			if job.Status != tt.wantStatus {
+				t.Errorf("Status = %d, want %d", job.Status, tt.wantStatus)
+			}
+			if job.Error != tt.wantError {
+				t.Errorf("Error = %q, want %q", job.Error, tt.wantError)
+			}
+			if job.QueryResultID != tt.wantQueryResultID {
+				t.Errorf("QueryResultID = %d, want %d", job.QueryResultID, tt.wantQueryResultID)
+			}
+			if string(job.Result) != tt.wantResult {
+				t.Errorf("Result = %s, want %s", job.Result, tt.wantResult)
+			}
+		})
+	}
+}
@@ -0,0 +1,505 @@
+package redash
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// パラメータの型
+const (
+	ParameterTypeText      = "text"
+	ParameterTypeNumber    = "number"
+	ParameterTypeDate      = "date"
+	ParameterTypeDateRange = "date-range"
+	ParameterTypeEnum      = "enum"
+	ParameterTypeQuery     = "query" // 別のクエリの結果をドロップダウンの選択肢にする
+
+	ParameterTypeDateTime                 = "datetime-local"
+	ParameterTypeDateTimeWithSeconds      = "datetime-with-seconds"
+	ParameterTypeDateTimeRange            = "datetime-range"
+	ParameterTypeDateTimeRangeWithSeconds = "datetime-range-with-seconds"
+)
+
+// 日付・日時パラメータの書式
+var parameterDateLayouts = map[string]string{
+	ParameterTypeDate:                     "2006-01-02",
+	ParameterTypeDateRange:                "2006-01-02",
+	ParameterTypeDateTime:                 "2006-01-02 15:04",
+	ParameterTypeDateTimeRange:            "2006-01-02 15:04",
+	ParameterTypeDateTimeWithSeconds:      "2006-01-02 15:04:05",
+	ParameterTypeDateTimeRangeWithSeconds: "2006-01-02 15:04:05",
+}
+
+// 日付パラメータで使用できる動的な値
+var dynamicDateValues = []string{"d_now", "d_yesterday"}
+
+// 期間パラメータで使用できる動的な値
+var dynamicDateRangeValues = []string{
+	"d_this_week", "d_this_month", "d_this_year",
+	"d_last_week", "d_last_month", "d_last_year",
+	"d_last_7_days", "d_last_14_days", "d_last_30_days", "d_last_60_days", "d_last_90_days", "d_last_12_months",
+}
+
+// AdhocParameterTypes はアドホッククエリで使用できるパラメータの型
+var AdhocParameterTypes = []string{
+	ParameterTypeText,
+	ParameterTypeNumber,
+	ParameterTypeDate,
+	ParameterTypeDateRange,
+	ParameterTypeEnum,
+}
+
+// parameterReferencePattern はクエリ中の {{ name }} / {{ name.start }} 形式の参照
+// {{# ... }} や {{> ... }} などの Mustache のタグは対象外
+var parameterReferencePattern = regexp.MustCompile(`\{\{\s*([A-Za-z_][A-Za-z0-9_]*)(?:\.[A-Za-z_][A-Za-z0-9_]*)?\s*\}\}`)
+
+// AdhocParameter はアドホッククエリに渡す型付きのパラメータ
+type AdhocParameter struct {
+	Type    string      // ParameterType* のいずれか
+	Value   interface{} // date-range の場合は {"start": ..., "end": ...}
+	Options []string    // enum の選択肢
+}
+
+// QueryParameter は保存済みクエリのパラメータ定義（options.parameters の要素）
+type QueryParameter struct {
+	Name               string              `json:"name"`
+	Title              string              `json:"title"`
+	Type               string              `json:"type"`
+	Value              interface{}         `json:"value"`                 // デフォルト値
+	EnumOptions        string              `json:"enumOptions,omitempty"` // enum の選択肢（改行区切り）
+	QueryID            *int                `json:"queryId,omitempty"`     // query 型の選択肢を返すクエリのID
+	Global             bool                `json:"global,omitempty"`
+	MultiValuesOptions *MultiValuesOptions `json:"multiValuesOptions,omitempty"` // 複数選択を許可する場合の展開方法
+}
+
+// MultiValuesOptions は複数選択された値を SQL に展開する際の前後の文字と区切り文字
+type MultiValuesOptions struct {
+	Prefix    string `json:"prefix"`
+	Suffix    string `json:"suffix"`
+	Separator string `json:"separator"`
+}
+
+// ParameterOption はドロップダウンの選択肢
+type ParameterOption struct {
+	Name  string      `json:"name"`
+	Value interface{} `json:"value"`
+}
+
+// Parameters はクエリの options.parameters を型付きで返す
+func (q *Query) Parameters() ([]QueryParameter, error) {
+	return parseQueryParameters(q.Options)
+}
+
+// Parameters はウィジェットのクエリの options.parameters を型付きで返す
+func (q *WidgetQuery) Parameters() ([]QueryParameter, error) {
+	return parseQueryParameters(q.Options)
+}
+
+// parseQueryParameters はクエリの options から parameters を取り出す
+func parseQueryParameters(options map[string]interface{}) ([]QueryParameter, error) {
+	raw, ok := options["parameters"]
+	if !ok || raw == nil {
+		return []QueryParameter{}, nil
+	}
+
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal parameters: %w", err)
+	}
+
+	var params []QueryParameter
+	if err := json.Unmarshal(data, &params); err != nil {
+		return nil, fmt.Errorf("failed to decode parameters: %w", err)
+	}
+
+	return params, nil
+}
+
+// EnumValues は enum パラメータの選択肢を返す
+func (p QueryParameter) EnumValues() []string {
+	var values []string
+	for _, line := range strings.Split(p.EnumOptions, "\n") {
+		line = strings.TrimSpace(line)
+		if line != "" {
+			values = append(values, line)
+		}
+	}
+	return values
+}
+
+// GetQueryDropdownOptions は query 型パラメータのドロップダウンの選択肢を取得
+// queryID: パラメータを持つクエリのID
+// dropdownQueryID: 選択肢を返すクエリのID（パラメータの queryId）
+func (c *Client) GetQueryDropdownOptions(queryID, dropdownQueryID int) ([]ParameterOption, error) {
+	url := fmt.Sprintf("%s/api/queries/%d/dropdowns/%d", c.BaseURL, queryID, dropdownQueryID)
+
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Key %s", c.APIKey))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	var options []ParameterOption
+	if err := json.NewDecoder(resp.Body).Decode(&options); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return options, nil
+}
+
+// QueryParameterNames はクエリ中で {{ name }} として参照されているパラメータ名を返す
+func QueryParameterNames(query string) []string {
+	seen := make(map[string]bool)
+	var names []string
+	for _, match := range parameterReferencePattern.FindAllStringSubmatch(query, -1) {
+		if !seen[match[1]] {
+			seen[match[1]] = true
+			names = append(names, match[1])
+		}
+	}
+	return names
+}
+
+// RenderAdhocParameters はパラメータを検証し、SQL のリテラルとして埋め込める値に変換
+// アドホッククエリにはパラメータのスキーマがなく、Redash は値をそのまま {{ name }} に展開するため、
+// 文字列や日付はここで引用符付きのリテラルにする
+func RenderAdhocParameters(query string, params map[string]AdhocParameter) (map[string]interface{}, error) {
+	// クエリ中の参照がすべて宣言されているか確認
+	referenced := make(map[string]bool)
+	for _, name := range QueryParameterNames(query) {
+		referenced[name] = true
+		if _, ok := params[name]; !ok {
+			return nil, fmt.Errorf("parameter %q is used in the query but not declared", name)
+		}
+	}
+
+	names := make([]string, 0, len(params))
+	for name := range params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	rendered := make(map[string]interface{}, len(params))
+	for _, name := range names {
+		if !referenced[name] {
+			return nil, fmt.Errorf("parameter %q is declared but not used in the query (reference it as {{ %s }})", name, name)
+		}
+
+		value, err := renderAdhocParameter(params[name])
+		if err != nil {
+			return nil, fmt.Errorf("parameter %q: %w", name, err)
+		}
+		rendered[name] = value
+	}
+
+	return rendered, nil
+}
+
+// renderAdhocParameter は型に応じて値を SQL のリテラルに変換
+func renderAdhocParameter(param AdhocParameter) (interface{}, error) {
+	switch param.Type {
+	case ParameterTypeText:
+		text, ok := param.Value.(string)
+		if !ok {
+			return nil, fmt.Errorf("text value must be a string")
+		}
+		return quoteSQLString(text)
+	case ParameterTypeNumber:
+		return renderNumber(param.Value)
+	case ParameterTypeDate:
+		return renderDate(param.Value)
+	case ParameterTypeDateRange:
+		dateRange, ok := param.Value.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf(`date-range value must be an object like {"start": "2024-01-01", "end": "2024-01-31"}`)
+		}
+		start, err := renderDate(dateRange["start"])
+		if err != nil {
+			return nil, fmt.Errorf("start: %w", err)
+		}
+		end, err := renderDate(dateRange["end"])
+		if err != nil {
+			return nil, fmt.Errorf("end: %w", err)
+		}
+		return map[string]string{"start": start, "end": end}, nil
+	case ParameterTypeEnum:
+		if len(param.Options) == 0 {
+			return nil, fmt.Errorf("enum parameter requires options")
+		}
+		text, ok := param.Value.(string)
+		if !ok {
+			return nil, fmt.Errorf("enum value must be a string")
+		}
+		valid := false
+		for _, option := range param.Options {
+			if text == option {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return nil, fmt.Errorf("enum value %q must be one of: %s", text, strings.Join(param.Options, ", "))
+		}
+		return quoteSQLString(text)
+	default:
+		return nil, fmt.Errorf("unknown parameter type %q (must be one of: %s)", param.Type, strings.Join(AdhocParameterTypes, ", "))
+	}
+}
+
+// quoteSQLString は文字列を単一引用符で囲み、引用符を二重にしてエスケープ
+// バックスラッシュをエスケープ文字として扱うデータベースがあるため、バックスラッシュは受け付けない
+func quoteSQLString(text string) (string, error) {
+	if strings.ContainsAny(text, "\\\x00") {
+		return "", fmt.Errorf("text value must not contain backslashes or NUL characters")
+	}
+	return "'" + strings.ReplaceAll(text, "'", "''") + "'", nil
+}
+
+// renderNumber は数値を SQL の数値リテラルに変換
+func renderNumber(value interface{}) (string, error) {
+	var number float64
+	switch v := value.(type) {
+	case float64:
+		number = v
+	case string:
+		parsed, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		if err != nil {
+			return "", fmt.Errorf("number value %q is not a number", v)
+		}
+		number = parsed
+	default:
+		return "", fmt.Errorf("number value must be a number")
+	}
+	if math.IsNaN(number) || math.IsInf(number, 0) {
+		return "", fmt.Errorf("number value must be finite")
+	}
+	// 負の数は括弧で囲む（10-{{ n }} が 10--5 となり、-- 以降が SQL のコメントになるのを防ぐ）
+	if number < 0 {
+		return "(" + strconv.FormatFloat(number, 'f', -1, 64) + ")", nil
+	}
+	// -0 は 0 として扱う
+	return strconv.FormatFloat(math.Abs(number), 'f', -1, 64), nil
+}
+
+// renderDate は YYYY-MM-DD 形式の日付を SQL の文字列リテラルに変換
+func renderDate(value interface{}) (string, error) {
+	text, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("date value must be a string in YYYY-MM-DD format")
+	}
+	date, err := time.Parse("2006-01-02", text)
+	if err != nil {
+		return "", fmt.Errorf("date value %q must be in YYYY-MM-DD format", text)
+	}
+	return "'" + date.Format("2006-01-02") + "'", nil
+}
+
+// ParameterError は1つのパラメータに対する検証エラー
+type ParameterError struct {
+	Name        string   `json:"name"`
+	Message     string   `json:"message"`
+	ValidValues []string `json:"valid_values,omitempty"`
+}
+
+// ParameterValidationError はパラメータの検証エラーの一覧
+type ParameterValidationError struct {
+	Errors []ParameterError `json:"errors"`
+}
+
+// Error は検証エラーを1行にまとめて返す
+func (e *ParameterValidationError) Error() string {
+	messages := make([]string, 0, len(e.Errors))
+	for _, paramErr := range e.Errors {
+		messages = append(messages, fmt.Sprintf("%s: %s", paramErr.Name, paramErr.Message))
+	}
+	return "invalid parameters: " + strings.Join(messages, "; ")
+}
+
+// ValidateQueryParameters は保存済みクエリのパラメータ定義に沿って値を検証
+// 指定されていないパラメータはデフォルト値で補い、補った後の値を返す
+// 検証に失敗した場合は *ParameterValidationError を返す
+func ValidateQueryParameters(definitions []QueryParameter, values map[string]interface{}) (map[string]interface{}, error) {
+	var errs []ParameterError
+
+	defined := make(map[string]QueryParameter, len(definitions))
+	names := make([]string, 0, len(definitions))
+	for _, definition := range definitions {
+		defined[definition.Name] = definition
+		names = append(names, definition.Name)
+	}
+
+	// 定義されていないパラメータ名
+	unknown := make([]string, 0)
+	for name := range values {
+		if _, ok := defined[name]; !ok {
+			unknown = append(unknown, name)
+		}
+	}
+	sort.Strings(unknown)
+	for _, name := range unknown {
+		errs = append(errs, ParameterError{
+			Name:        name,
+			Message:     "unknown parameter",
+			ValidValues: names,
+		})
+	}
+
+	result := make(map[string]interface{}, len(definitions))
+	for _, definition := range definitions {
+		value, ok := values[definition.Name]
+		if !ok || value == nil {
+			// 指定がない場合はデフォルト値を使う
+			if isEmptyParameterValue(definition.Value) {
+				errs = append(errs, ParameterError{
+					Name:    definition.Name,
+					Message: fmt.Sprintf("value is required (type: %s)", definition.Type),
+				})
+				continue
+			}
+			result[definition.Name] = definition.Value
+			continue
+		}
+
+		if paramErr := validateQueryParameter(definition, value); paramErr != nil {
+			errs = append(errs, *paramErr)
+			continue
+		}
+		result[definition.Name] = value
+	}
+
+	if len(errs) > 0 {
+		return nil, &ParameterValidationError{Errors: errs}
+	}
+
+	return result, nil
+}
+
+// validateQueryParameter はパラメータの型に応じて値を検証
+func validateQueryParameter(definition QueryParameter, value interface{}) *ParameterError {
+	switch definition.Type {
+	case ParameterTypeText:
+		if _, ok := value.(string); !ok {
+			return &ParameterError{Name: definition.Name, Message: "value must be a string"}
+		}
+	case ParameterTypeNumber:
+		if _, err := renderNumber(value); err != nil {
+			return &ParameterError{Name: definition.Name, Message: err.Error()}
+		}
+	case ParameterTypeEnum:
+		validValues := definition.EnumValues()
+		if _, ok := value.([]interface{}); ok && definition.MultiValuesOptions == nil {
+			return &ParameterError{Name: definition.Name, Message: "value must be a single option (multiple values are not allowed)", ValidValues: validValues}
+		}
+		for _, item := range parameterValueItems(definition, value) {
+			text, ok := item.(string)
+			if !ok || !containsString(validValues, text) {
+				return &ParameterError{
+					Name:        definition.Name,
+					Message:     fmt.Sprintf("value %v is not one of the allowed options", item),
+					ValidValues: validValues,
+				}
+			}
+		}
+	case ParameterTypeQuery:
+		// 選択肢は別のクエリの結果のため、ここでは値の形式のみ確認する
+		if _, ok := value.([]interface{}); ok && definition.MultiValuesOptions == nil {
+			return &ParameterError{Name: definition.Name, Message: "value must be a single option (multiple values are not allowed)"}
+		}
+		for _, item := range parameterValueItems(definition, value) {
+			switch item.(type) {
+			case string, float64:
+			default:
+				return &ParameterError{Name: definition.Name, Message: "value must be a string or number (see get_parameter_options for the allowed values)"}
+			}
+		}
+	case ParameterTypeDate, ParameterTypeDateTime, ParameterTypeDateTimeWithSeconds:
+		layout := parameterDateLayouts[definition.Type]
+		text, ok := value.(string)
+		if !ok || (!containsString(dynamicDateValues, text) && !isValidDate(layout, text)) {
+			return &ParameterError{
+				Name:        definition.Name,
+				Message:     fmt.Sprintf("value must be a date in %s format or a dynamic date", dateFormatLabel(layout)),
+				ValidValues: dynamicDateValues,
+			}
+		}
+	case ParameterTypeDateRange, ParameterTypeDateTimeRange, ParameterTypeDateTimeRangeWithSeconds:
+		layout := parameterDateLayouts[definition.Type]
+		if text, ok := value.(string); ok && containsString(dynamicDateRangeValues, text) {
+			return nil
+		}
+		dateRange, ok := value.(map[string]interface{})
+		start, startOK := dateRange["start"].(string)
+		end, endOK := dateRange["end"].(string)
+		if !ok || !startOK || !endOK || !isValidDate(layout, start) || !isValidDate(layout, end) {
+			return &ParameterError{
+				Name:        definition.Name,
+				Message:     fmt.Sprintf(`value must be {"start": ..., "end": ...} with dates in %s format, or a dynamic date range`, dateFormatLabel(layout)),
+				ValidValues: dynamicDateRangeValues,
+			}
+		}
+	}
+
+	return nil
+}
+
+// parameterValueItems は複数選択を許可するパラメータの場合は配列の要素を、それ以外は値そのものを返す
+func parameterValueItems(definition QueryParameter, value interface{}) []interface{} {
+	if items, ok := value.([]interface{}); ok && definition.MultiValuesOptions != nil {
+		return items
+	}
+	return []interface{}{value}
+}
+
+// isEmptyParameterValue はデフォルト値が設定されていないかを判定
+func isEmptyParameterValue(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case []interface{}:
+		return len(v) == 0
+	}
+	return false
+}
+
+// isValidDate は日付が書式に沿っているかを判定
+func isValidDate(layout, text string) bool {
+	_, err := time.Parse(layout, text)
+	return err == nil
+}
+
+// dateFormatLabel は Go の日付書式を YYYY-MM-DD 形式の表記に変換
+func dateFormatLabel(layout string) string {
+	return strings.NewReplacer("2006", "YYYY", "01", "MM", "02", "DD", "15", "HH", "04", "mm", "05", "ss").Replace(layout)
+}
+
+// containsString はスライスに文字列が含まれるかを判定
+func containsString(values []string, target string) bool {
+	for _, value := range values {
+		if value == target {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,36 @@
+package redash
+
+import "testing"
+
+func TestRenderAdhocParametersNegativeNumber(t *testing.T) {
+	tests := []struct {
+		name  string
+		value interface{}
+		want  string
+	}{
+		{name: "negative", value: float64(-5), want: "SELECT 10-(-5) AS n WHERE x = 1"},
+		{name: "negative string", value: "-2.5", want: "SELECT 10-(-2.5) AS n WHERE x = 1"},
+		{name: "positive", value: float64(5), want: "SELECT 10-5 AS n WHERE x = 1"},
+		{name: "negative zero", value: "-0", want: "SELECT 10-0 AS n WHERE x = 1"},
+	}
+
+	query := "SELECT 10-{{ n }} AS n WHERE x = 1"
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rendered, err := RenderAdhocParameters(query, map[string]AdhocParameter{
+				"n": {Type: ParameterTypeNumber, Value: tt.value},
+			})
+			if err != nil {
+				t.Fatalf("RenderAdhocParameters() error = %v", err)
+			}
+
+			// Redash と同じく {{ n }} を値で置き換える
+			got := parameterReferencePattern.ReplaceAllStringFunc(query, func(string) string {
+				return rendered["n"].(string)
+			})
+			if got != tt.want {
+				t.Errorf("rendered query = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package redash
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// ACL を持つオブジェクトの種類（API のパス）
+const (
+	ACLObjectQueries    = "queries"
+	ACLObjectDashboards = "dashboards"
+)
+
+// AccessTypeModify は編集権限
+const AccessTypeModify = "modify"
+
+// AccessControlList はオブジェクトの権限
+// 作成者と管理者は ACL に含まれなくても編集できる
+type AccessControlList struct {
+	Modify []User `json:"modify"`
+}
+
+// accessRequest は権限の付与・取り消しのリクエスト
+type accessRequest struct {
+	AccessType string `json:"access_type"`
+	UserID     int    `json:"user_id"`
+}
+
+// GetACL はクエリ・ダッシュボードの権限を取得
+// objectType: ACLObjectQueries または ACLObjectDashboards
+func (c *Client) GetACL(objectType string, objectID int) (*AccessControlList, error) {
+	url := fmt.Sprintf("%s/api/%s/%d/acl", c.BaseURL, objectType, objectID)
+
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Key %s", c.APIKey))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	var acl AccessControlList
+	if err := json.NewDecoder(resp.Body).Decode(&acl); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &acl, nil
+}
+
+// GrantAccess はユーザーにクエリ・ダッシュボードの権限を付与
+func (c *Client) GrantAccess(objectType string, objectID int, accessType string, userID int) error {
+	return c.changeAccess("POST", objectType, objectID, accessType, userID)
+}
+
+// RevokeAccess はユーザーからクエリ・ダッシュボードの権限を取り消す
+func (c *Client) RevokeAccess(objectType string, objectID int, accessType string, userID int) error {
+	return c.changeAccess("DELETE", objectType, objectID, accessType, userID)
+}
+
+// changeAccess は ACL API に権限の付与（POST）・取り消し（DELETE）を送る
+func (c *Client) changeAccess(method, objectType string, objectID int, accessType string, userID int) error {
+	url := fmt.Sprintf("%s/api/%s/%d/acl", c.BaseURL, objectType, objectID)
+
+	data, err := json.Marshal(accessRequest{
+		AccessType: accessType,
+		UserID:     userID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequest(method, url, bytes.NewBuffer(data))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Key %s", c.APIKey))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	return nil
+}
@@ -0,0 +1,300 @@
+package redash
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// QuerySummary はクエリ一覧・検索結果に含まれるクエリの要約
+type QuerySummary struct {
+	ID           int      `json:"id"`
+	Name         string   `json:"name"`
+	Description  string   `json:"description"`
+	DataSourceID int      `json:"data_source_id"`
+	Tags         []string `json:"tags"`
+	IsDraft      bool     `json:"is_draft"`
+	IsArchived   bool     `json:"is_archived"`
+	IsFavorite   bool     `json:"is_favorite"`
+	CreatedAt    string   `json:"created_at"`
+	UpdatedAt    string   `json:"updated_at"`
+}
+
+// QueryList はページネーション付きのクエリ一覧
+type QueryList struct {
+	Count    int            `json:"count"`
+	Page     int            `json:"page"`
+	PageSize int            `json:"page_size"`
+	Results  []QuerySummary `json:"results"`
+}
+
+// QueryListOptions はクエリ一覧・検索の絞り込み条件
+type QueryListOptions struct {
+	Page          int      // ページ番号（1始まり、0 の場合は Redash のデフォルト）
+	PageSize      int      // 1ページあたりの件数（0 の場合は Redash のデフォルト）
+	Search        string   // 名前・説明・SQL に対するテキスト検索
+	Tags          []string // タグによる絞り込み（すべてのタグを持つクエリのみ）
+	FavoritesOnly bool     // お気に入りのクエリのみ（ListQueries のみ）
+}
+
+// values はクエリ文字列に変換
+func (o QueryListOptions) values() url.Values {
+	params := url.Values{}
+	if o.Page > 0 {
+		params.Set("page", strconv.Itoa(o.Page))
+	}
+	if o.PageSize > 0 {
+		params.Set("page_size", strconv.Itoa(o.PageSize))
+	}
+	if o.Search != "" {
+		params.Set("q", o.Search)
+	}
+	for _, tag := range o.Tags {
+		params.Add("tags", tag)
+	}
+	return params
+}
+
+// CreateQueryInput はクエリ作成時の入力
+type CreateQueryInput struct {
+	Name         string                 `json:"name"`
+	Query        string                 `json:"query"`
+	DataSourceID int                    `json:"data_source_id"`
+	Description  string                 `json:"description,omitempty"`
+	Tags         []string               `json:"tags,omitempty"`
+	Options      map[string]interface{} `json:"options,omitempty"`
+}
+
+// UpdateQueryInput はクエリ更新時の入力
+// nil のフィールドは送信せず、Redash 側の値を変更しない
+type UpdateQueryInput struct {
+	Name        *string                `json:"name,omitempty"`
+	Query       *string                `json:"query,omitempty"`
+	Description *string                `json:"description,omitempty"`
+	Tags        *[]string              `json:"tags,omitempty"`
+	Options     map[string]interface{} `json:"options,omitempty"`
+	IsDraft     *bool                  `json:"is_draft,omitempty"`
+	IsArchived  *bool                  `json:"is_archived,omitempty"`
+}
+
+// ListQueries は保存済みクエリの一覧を取得
+func (c *Client) ListQueries(opts QueryListOptions) (*QueryList, error) {
+	path := "/api/queries"
+	if opts.FavoritesOnly {
+		path = "/api/queries/favorites"
+	}
+	url := fmt.Sprintf("%s%s?%s", c.BaseURL, path, opts.values().Encode())
+
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Key %s", c.APIKey))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	var list QueryList
+	if err := json.NewDecoder(resp.Body).Decode(&list); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &list, nil
+}
+
+// SearchQueries はクエリをテキスト検索
+// Redash のバージョンによって /api/queries/search は配列またはページネーション付きの
+// オブジェクトを返すため、どちらの場合も QueryList に揃えて返す
+func (c *Client) SearchQueries(opts QueryListOptions) (*QueryList, error) {
+	url := fmt.Sprintf("%s/api/queries/search?%s", c.BaseURL, opts.values().Encode())
+
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Key %s", c.APIKey))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	bodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	// パターン1: ページネーション付きのオブジェクト
+	var list QueryList
+	if err := json.Unmarshal(bodyBytes, &list); err == nil {
+		return &list, nil
+	}
+
+	// パターン2: クエリの配列（古い Redash）
+	var results []QuerySummary
+	if err := json.Unmarshal(bodyBytes, &results); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &QueryList{
+		Count:    len(results),
+		Page:     opts.Page,
+		PageSize: opts.PageSize,
+		Results:  results,
+	}, nil
+}
+
+// CreateQuery は新しいクエリを作成
+// Redash では作成直後のクエリは下書き（is_draft）になる
+func (c *Client) CreateQuery(input CreateQueryInput) (*Query, error) {
+	url := fmt.Sprintf("%s/api/queries", c.BaseURL)
+
+	data, err := json.Marshal(input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequest("POST", url, bytes.NewBuffer(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Key %s", c.APIKey))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	var query Query
+	if err := json.NewDecoder(resp.Body).Decode(&query); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &query, nil
+}
+
+// UpdateQuery は既存のクエリを更新
+func (c *Client) UpdateQuery(queryID int, input UpdateQueryInput) (*Query, error) {
+	url := fmt.Sprintf("%s/api/queries/%d", c.BaseURL, queryID)
+
+	data, err := json.Marshal(input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequest("POST", url, bytes.NewBuffer(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Key %s", c.APIKey))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	var query Query
+	if err := json.NewDecoder(resp.Body).Decode(&query); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &query, nil
+}
+
+// ForkQuery は既存のクエリを複製して新しいクエリを作成
+func (c *Client) ForkQuery(queryID int) (*Query, error) {
+	url := fmt.Sprintf("%s/api/queries/%d/fork", c.BaseURL, queryID)
+
+	req, err := http.NewRequest("POST", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Key %s", c.APIKey))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	var query Query
+	if err := json.NewDecoder(resp.Body).Decode(&query); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &query, nil
+}
+
+// ArchiveQuery はクエリをアーカイブ
+// Redash ではクエリの削除はアーカイブとして扱われ、UnarchiveQuery で元に戻せる
+func (c *Client) ArchiveQuery(queryID int) error {
+	url := fmt.Sprintf("%s/api/queries/%d", c.BaseURL, queryID)
+
+	req, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Key %s", c.APIKey))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	return nil
+}
+
+// UnarchiveQuery はアーカイブ済みのクエリを元に戻す
+func (c *Client) UnarchiveQuery(queryID int) (*Query, error) {
+	isArchived := false
+	return c.UpdateQuery(queryID, UpdateQueryInput{IsArchived: &isArchived})
+}
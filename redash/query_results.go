@@ -0,0 +1,58 @@
+package redash
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// GetQueryResult は保存済みのクエリ結果を ID で取得
+// クエリを再実行しないため、ウェアハウスに負荷をかけずに結果を読める
+func (c *Client) GetQueryResult(queryResultID int) (*QueryResult, error) {
+	url := fmt.Sprintf("%s/api/query_results/%d", c.BaseURL, queryResultID)
+
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Key %s", c.APIKey))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	var result QueryExecuteResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	if result.QueryResult == nil {
+		return nil, fmt.Errorf("unexpected response format: no query_result found")
+	}
+
+	return result.QueryResult, nil
+}
+
+// GetLatestQueryResult は保存済みクエリの最新のキャッシュ済み結果を取得
+// クエリの latest_query_data_id をたどるため、クエリは実行されない
+func (c *Client) GetLatestQueryResult(queryID int) (*QueryResult, error) {
+	query, err := c.GetQuery(queryID)
+	if err != nil {
+		return nil, err
+	}
+
+	if query.LatestQueryDataID == nil {
+		return nil, fmt.Errorf("query %d has no cached result yet", queryID)
+	}
+
+	return c.GetQueryResult(*query.LatestQueryDataID)
+}
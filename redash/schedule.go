@@ -0,0 +1,146 @@
+package redash
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// スケジュールの間隔（秒）
+const (
+	ScheduleIntervalDay  = 24 * 60 * 60
+	ScheduleIntervalWeek = 7 * ScheduleIntervalDay
+)
+
+// ScheduleDaysOfWeek は day_of_week に指定できる曜日
+var ScheduleDaysOfWeek = []string{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday", "Sunday"}
+
+// QuerySchedule はクエリの定期実行のスケジュール
+// 1日未満の間隔は Interval のみ、日次は Time、週次は Time と DayOfWeek を指定する
+type QuerySchedule struct {
+	Interval  int     `json:"interval"`    // 実行間隔（秒）
+	Time      *string `json:"time"`        // 実行時刻（UTC、HH:MM）
+	DayOfWeek *string `json:"day_of_week"` // 実行する曜日（週次の場合）
+	Until     *string `json:"until"`       // 定期実行を終了する日（YYYY-MM-DD）
+}
+
+// UnmarshalJSON は interval を数値または文字列のどちらからでも読み込む
+// 古い Redash は interval を文字列で返すため
+func (s *QuerySchedule) UnmarshalJSON(data []byte) error {
+	type querySchedule struct {
+		Interval  interface{} `json:"interval"`
+		Time      *string     `json:"time"`
+		DayOfWeek *string     `json:"day_of_week"`
+		Until     *string     `json:"until"`
+	}
+	var schedule querySchedule
+	if err := json.Unmarshal(data, &schedule); err != nil {
+		return err
+	}
+
+	*s = QuerySchedule{
+		Time:      schedule.Time,
+		DayOfWeek: schedule.DayOfWeek,
+		Until:     schedule.Until,
+	}
+	switch v := schedule.Interval.(type) {
+	case float64:
+		s.Interval = int(v)
+	case string:
+		if v != "" {
+			interval, err := strconv.Atoi(v)
+			if err != nil {
+				return fmt.Errorf("invalid schedule interval %q", v)
+			}
+			s.Interval = interval
+		}
+	}
+	return nil
+}
+
+// ValidateQuerySchedule はスケジュールが Redash で有効な組み合わせかを検証
+func ValidateQuerySchedule(schedule QuerySchedule) error {
+	if schedule.Interval < 60 {
+		return fmt.Errorf("interval must be at least 60 seconds")
+	}
+
+	if schedule.Interval >= ScheduleIntervalDay {
+		if schedule.Interval%ScheduleIntervalDay != 0 {
+			return fmt.Errorf("interval of a day or longer must be a multiple of %d seconds (1 day)", ScheduleIntervalDay)
+		}
+		if schedule.Time == nil {
+			return fmt.Errorf("time (HH:MM in UTC) is required for daily and weekly schedules")
+		}
+	} else if schedule.Time != nil {
+		return fmt.Errorf("time can only be set for schedules of a day or longer")
+	}
+
+	if schedule.Time != nil {
+		if _, err := time.Parse("15:04", *schedule.Time); err != nil {
+			return fmt.Errorf("time %q must be in HH:MM format (UTC)", *schedule.Time)
+		}
+	}
+
+	if schedule.Interval >= ScheduleIntervalWeek && schedule.Interval%ScheduleIntervalWeek == 0 {
+		if schedule.DayOfWeek == nil {
+			return fmt.Errorf("day_of_week is required for weekly schedules (one of: %s)", strings.Join(ScheduleDaysOfWeek, ", "))
+		}
+		if !containsString(ScheduleDaysOfWeek, *schedule.DayOfWeek) {
+			return fmt.Errorf("day_of_week %q must be one of: %s", *schedule.DayOfWeek, strings.Join(ScheduleDaysOfWeek, ", "))
+		}
+	} else if schedule.DayOfWeek != nil {
+		return fmt.Errorf("day_of_week can only be set for weekly schedules (interval a multiple of %d seconds)", ScheduleIntervalWeek)
+	}
+
+	if schedule.Until != nil {
+		if _, err := time.Parse("2006-01-02", *schedule.Until); err != nil {
+			return fmt.Errorf("until %q must be in YYYY-MM-DD format", *schedule.Until)
+		}
+	}
+
+	return nil
+}
+
+// SetQuerySchedule はクエリの定期実行のスケジュールを設定
+// schedule が nil の場合は定期実行を停止する
+func (c *Client) SetQuerySchedule(queryID int, schedule *QuerySchedule) (*Query, error) {
+	url := fmt.Sprintf("%s/api/queries/%d", c.BaseURL, queryID)
+
+	data, err := json.Marshal(map[string]interface{}{
+		"schedule": schedule,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequest("POST", url, bytes.NewBuffer(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Key %s", c.APIKey))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	var query Query
+	if err := json.NewDecoder(resp.Body).Decode(&query); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &query, nil
+}
@@ -0,0 +1,117 @@
+package redash
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// スキーマ取得ジョブの完了を待つ最大時間
+const schemaJobTimeout = 30 * time.Second
+
+// SchemaTable はデータソースのテーブル
+type SchemaTable struct {
+	Name    string         `json:"name"`
+	Columns []SchemaColumn `json:"columns"`
+}
+
+// SchemaColumn はテーブルのカラム
+// 型情報はデータソースが提供する場合のみ設定される
+type SchemaColumn struct {
+	Name string `json:"name"`
+	Type string `json:"type,omitempty"`
+}
+
+// UnmarshalJSON はカラムを文字列または {"name": ..., "type": ...} のどちらからでも読み込む
+// Redash のバージョンによってカラム名のみの配列が返るため
+func (c *SchemaColumn) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err == nil {
+		*c = SchemaColumn{Name: name}
+		return nil
+	}
+
+	type schemaColumn SchemaColumn
+	var column schemaColumn
+	if err := json.Unmarshal(data, &column); err != nil {
+		return err
+	}
+	*c = SchemaColumn(column)
+	return nil
+}
+
+// schemaResponse はスキーマ取得結果（2パターンある）
+// パターン1: 同期的に返す場合 {"schema": [...]} または {"error": {...}}
+// パターン2: バックグラウンドで取得する場合 {"job": {...}}
+type schemaResponse struct {
+	Schema []SchemaTable `json:"schema"`
+	Error  *schemaError  `json:"error,omitempty"`
+	Job    *QueryJob     `json:"job,omitempty"`
+}
+
+type schemaError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// GetSchema はデータソースのスキーマ（テーブルとカラム）を取得
+// refresh: true の場合は Redash のキャッシュを使わずに再取得する
+func (c *Client) GetSchema(dataSourceID int, refresh bool) ([]SchemaTable, error) {
+	url := fmt.Sprintf("%s/api/data_sources/%d/schema", c.BaseURL, dataSourceID)
+	if refresh {
+		url += "?refresh=true"
+	}
+
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Key %s", c.APIKey))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	var result schemaResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to get schema (code %d): %s", result.Error.Code, result.Error.Message)
+	}
+
+	// パターン2: ジョブの完了を待つ
+	if result.Job != nil {
+		job, err := c.waitForJobSuccess(result.Job.ID, schemaJobTimeout)
+		if err != nil {
+			return nil, fmt.Errorf("schema refresh failed: %w", err)
+		}
+
+		// 結果はスキーマの配列、またはスキーマを取得できない場合は {"error": {...}}
+		var schema []SchemaTable
+		if err := json.Unmarshal(job.Result, &schema); err == nil {
+			return schema, nil
+		}
+		var jobResult schemaResponse
+		if err := json.Unmarshal(job.Result, &jobResult); err != nil {
+			return nil, fmt.Errorf("failed to decode schema: %w", err)
+		}
+		if jobResult.Error != nil {
+			return nil, fmt.Errorf("failed to get schema (code %d): %s", jobResult.Error.Code, jobResult.Error.Message)
+		}
+		return jobResult.Schema, nil
+	}
+
+	return result.Schema, nil
+}
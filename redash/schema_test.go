@@ -0,0 +1,42 @@
+package redash
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetSchemaRefreshJob(t *testing.T) {
+	// スキーマ取得ジョブの完了時、Redash は result と query_result_id の両方にテーブルの配列を設定する
+	tables := `[{"name": "public.users", "columns": [{"name": "id", "type": "integer"}, "email"]}]`
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/data_sources/1/schema":
+			fmt.Fprint(w, `{"job": {"id": "schema-job", "updated_at": 0, "status": 1, "error": null, "result": null, "query_result_id": null}}`)
+		case "/api/jobs/schema-job":
+			fmt.Fprintf(w, `{"job": {"id": "schema-job", "updated_at": 0, "status": 3, "error": null, "result": %s, "query_result_id": %s}}`, tables, tables)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	schema, err := NewClient(srv.URL, "key", true).GetSchema(1, true)
+	if err != nil {
+		t.Fatalf("GetSchema() error = %v", err)
+	}
+
+	if len(schema) != 1 || schema[0].Name != "public.users" {
+		t.Fatalf("GetSchema() = %+v, want the public.users table", schema)
+	}
+	want := []SchemaColumn{{Name: "id", Type: "integer"}, {Name: "email"}}
+	if len(schema[0].Columns) != len(want) {
+		t.Fatalf("columns = %+v, want %+v", schema[0].Columns, want)
+	}
+	for i, column := range schema[0].Columns {
+		if column != want[i] {
+			t.Errorf("columns[%d] = %+v, want %+v", i, column, want[i])
+		}
+	}
+}
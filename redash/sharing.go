@@ -0,0 +1,120 @@
+package redash
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// DashboardShare はダッシュボードの公開リンク
+type DashboardShare struct {
+	PublicURL string `json:"public_url"`
+	APIKey    string `json:"api_key"`
+}
+
+// queryAPIKeyResponse はクエリの API キーのみを読み込むためのレスポンス
+// Query には API キーを含めず、get_query の結果に出さない
+type queryAPIKeyResponse struct {
+	APIKey string `json:"api_key"`
+}
+
+// ShareDashboard はダッシュボードの公開リンクを有効化
+// 公開リンクを知っていれば Redash にログインしていなくてもダッシュボードを閲覧できる
+func (c *Client) ShareDashboard(dashboardID int) (*DashboardShare, error) {
+	url := fmt.Sprintf("%s/api/dashboards/%d/share", c.BaseURL, dashboardID)
+
+	req, err := http.NewRequest("POST", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Key %s", c.APIKey))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	var share DashboardShare
+	if err := json.NewDecoder(resp.Body).Decode(&share); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &share, nil
+}
+
+// UnshareDashboard はダッシュボードの公開リンクを無効化
+func (c *Client) UnshareDashboard(dashboardID int) error {
+	url := fmt.Sprintf("%s/api/dashboards/%d/share", c.BaseURL, dashboardID)
+
+	req, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Key %s", c.APIKey))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	return nil
+}
+
+// GetQueryAPIKey はクエリ固有の API キーを取得
+// このキーではそのクエリの結果のみを取得できる
+func (c *Client) GetQueryAPIKey(queryID int) (string, error) {
+	url := fmt.Sprintf("%s/api/queries/%d", c.BaseURL, queryID)
+
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Key %s", c.APIKey))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return "", fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	var result queryAPIKeyResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return "", fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	if result.APIKey == "" {
+		return "", fmt.Errorf("query %d has no API key (the API key's user may not be allowed to see it)", queryID)
+	}
+
+	return result.APIKey, nil
+}
+
+// QueryResultsURL はクエリ固有の API キーで最新の結果を取得する URL を返す
+// format: "csv" または "json"
+func (c *Client) QueryResultsURL(queryID int, queryAPIKey, format string) string {
+	params := url.Values{}
+	params.Set("api_key", queryAPIKey)
+	return fmt.Sprintf("%s/api/queries/%d/results.%s?%s", c.BaseURL, queryID, format, params.Encode())
+}
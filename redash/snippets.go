@@ -0,0 +1,219 @@
+package redash
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// スニペット展開の最大の深さ（スニペット内のスニペット参照の循環を防ぐ）
+const maxSnippetExpansionDepth = 5
+
+// snippetReferencePattern はクエリ中の {{> trigger }} 形式のスニペット参照
+var snippetReferencePattern = regexp.MustCompile(`\{\{>\s*([^{}\s]+)\s*\}\}`)
+
+// QuerySnippet はクエリスニペット（共有の SQL 断片）
+type QuerySnippet struct {
+	ID          int    `json:"id"`
+	Trigger     string `json:"trigger"`
+	Description string `json:"description"`
+	Snippet     string `json:"snippet"`
+	User        *User  `json:"user,omitempty"`
+	CreatedAt   string `json:"created_at"`
+	UpdatedAt   string `json:"updated_at"`
+}
+
+// CreateQuerySnippetInput はスニペット作成時の入力
+type CreateQuerySnippetInput struct {
+	Trigger     string `json:"trigger"`
+	Description string `json:"description"`
+	Snippet     string `json:"snippet"`
+}
+
+// UpdateQuerySnippetInput はスニペット更新時の入力
+// nil のフィールドは送信せず、Redash 側の値を変更しない
+type UpdateQuerySnippetInput struct {
+	Trigger     *string `json:"trigger,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Snippet     *string `json:"snippet,omitempty"`
+}
+
+// ExpandSnippets はクエリ中の {{> trigger }} をスニペットの本文に置き換える
+// 存在しないトリガーが参照されている場合はエラーを返す
+func ExpandSnippets(query string, snippets []QuerySnippet) (string, error) {
+	byTrigger := make(map[string]string, len(snippets))
+	for _, snippet := range snippets {
+		byTrigger[snippet.Trigger] = snippet.Snippet
+	}
+
+	for depth := 0; depth < maxSnippetExpansionDepth; depth++ {
+		if !snippetReferencePattern.MatchString(query) {
+			return query, nil
+		}
+
+		var unknown []string
+		query = snippetReferencePattern.ReplaceAllStringFunc(query, func(reference string) string {
+			trigger := snippetReferencePattern.FindStringSubmatch(reference)[1]
+			snippet, ok := byTrigger[trigger]
+			if !ok {
+				if !containsString(unknown, trigger) {
+					unknown = append(unknown, trigger)
+				}
+				return reference
+			}
+			return snippet
+		})
+
+		if len(unknown) > 0 {
+			triggers := make([]string, 0, len(byTrigger))
+			for trigger := range byTrigger {
+				triggers = append(triggers, trigger)
+			}
+			sort.Strings(triggers)
+			return "", fmt.Errorf("unknown snippet %s (available: %s)", strings.Join(unknown, ", "), strings.Join(triggers, ", "))
+		}
+	}
+
+	if snippetReferencePattern.MatchString(query) {
+		return "", fmt.Errorf("snippets are nested more than %d levels deep (circular reference?)", maxSnippetExpansionDepth)
+	}
+
+	return query, nil
+}
+
+// ListQuerySnippets はクエリスニペットの一覧を取得
+func (c *Client) ListQuerySnippets() ([]QuerySnippet, error) {
+	url := fmt.Sprintf("%s/api/query_snippets", c.BaseURL)
+
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Key %s", c.APIKey))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	var snippets []QuerySnippet
+	if err := json.NewDecoder(resp.Body).Decode(&snippets); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return snippets, nil
+}
+
+// GetQuerySnippet はクエリスニペットを取得
+func (c *Client) GetQuerySnippet(snippetID int) (*QuerySnippet, error) {
+	url := fmt.Sprintf("%s/api/query_snippets/%d", c.BaseURL, snippetID)
+
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Key %s", c.APIKey))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	var snippet QuerySnippet
+	if err := json.NewDecoder(resp.Body).Decode(&snippet); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &snippet, nil
+}
+
+// CreateQuerySnippet は新しいクエリスニペットを作成
+func (c *Client) CreateQuerySnippet(input CreateQuerySnippetInput) (*QuerySnippet, error) {
+	url := fmt.Sprintf("%s/api/query_snippets", c.BaseURL)
+
+	data, err := json.Marshal(input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequest("POST", url, bytes.NewBuffer(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Key %s", c.APIKey))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	var snippet QuerySnippet
+	if err := json.NewDecoder(resp.Body).Decode(&snippet); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &snippet, nil
+}
+
+// UpdateQuerySnippet は既存のクエリスニペットを更新
+func (c *Client) UpdateQuerySnippet(snippetID int, input UpdateQuerySnippetInput) (*QuerySnippet, error) {
+	url := fmt.Sprintf("%s/api/query_snippets/%d", c.BaseURL, snippetID)
+
+	data, err := json.Marshal(input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequest("POST", url, bytes.NewBuffer(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Key %s", c.APIKey))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	var snippet QuerySnippet
+	if err := json.NewDecoder(resp.Body).Decode(&snippet); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &snippet, nil
+}
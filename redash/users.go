@@ -0,0 +1,228 @@
+package redash
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// UserGroup はユーザーが所属するグループ
+type UserGroup struct {
+	ID   int    `json:"id"`
+	Name string `json:"name,omitempty"`
+}
+
+// UnmarshalJSON はグループをIDまたは {"id": ..., "name": ...} のどちらからでも読み込む
+// /api/users/:id はグループIDの配列、/api/users は名前付きの配列を返すため
+func (g *UserGroup) UnmarshalJSON(data []byte) error {
+	var id int
+	if err := json.Unmarshal(data, &id); err == nil {
+		*g = UserGroup{ID: id}
+		return nil
+	}
+
+	type userGroup UserGroup
+	var group userGroup
+	if err := json.Unmarshal(data, &group); err != nil {
+		return err
+	}
+	*g = UserGroup(group)
+	return nil
+}
+
+// UserList はページネーション付きのユーザー一覧
+type UserList struct {
+	Count    int    `json:"count"`
+	Page     int    `json:"page"`
+	PageSize int    `json:"page_size"`
+	Results  []User `json:"results"`
+}
+
+// UserListOptions はユーザー一覧の絞り込み条件
+type UserListOptions struct {
+	Page     int    // ページ番号（1始まり、0 の場合は Redash のデフォルト）
+	PageSize int    // 1ページあたりの件数（0 の場合は Redash のデフォルト）
+	Search   string // 名前・メールアドレスに対するテキスト検索
+	Disabled bool   // 無効化されたユーザーのみを取得
+}
+
+// values はクエリ文字列に変換
+func (o UserListOptions) values() url.Values {
+	params := url.Values{}
+	if o.Page > 0 {
+		params.Set("page", strconv.Itoa(o.Page))
+	}
+	if o.PageSize > 0 {
+		params.Set("page_size", strconv.Itoa(o.PageSize))
+	}
+	if o.Search != "" {
+		params.Set("q", o.Search)
+	}
+	if o.Disabled {
+		params.Set("disabled", "true")
+	}
+	return params
+}
+
+// Group は Redash のグループ
+type Group struct {
+	ID          int      `json:"id"`
+	Name        string   `json:"name"`
+	Type        string   `json:"type"` // "builtin" または "regular"
+	Permissions []string `json:"permissions,omitempty"`
+	CreatedAt   string   `json:"created_at,omitempty"`
+}
+
+// ListUsers はユーザーの一覧を取得
+func (c *Client) ListUsers(opts UserListOptions) (*UserList, error) {
+	url := fmt.Sprintf("%s/api/users?%s", c.BaseURL, opts.values().Encode())
+
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Key %s", c.APIKey))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	var list UserList
+	if err := json.NewDecoder(resp.Body).Decode(&list); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &list, nil
+}
+
+// GetUser はユーザーの詳細を取得
+func (c *Client) GetUser(userID int) (*User, error) {
+	url := fmt.Sprintf("%s/api/users/%d", c.BaseURL, userID)
+
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Key %s", c.APIKey))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	var user User
+	if err := json.NewDecoder(resp.Body).Decode(&user); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &user, nil
+}
+
+// ListGroups はグループの一覧を取得
+func (c *Client) ListGroups() ([]Group, error) {
+	url := fmt.Sprintf("%s/api/groups", c.BaseURL)
+
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Key %s", c.APIKey))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	var groups []Group
+	if err := json.NewDecoder(resp.Body).Decode(&groups); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return groups, nil
+}
+
+// ListGroupMembers はグループに所属するユーザーの一覧を取得
+func (c *Client) ListGroupMembers(groupID int) ([]User, error) {
+	url := fmt.Sprintf("%s/api/groups/%d/members", c.BaseURL, groupID)
+
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Key %s", c.APIKey))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	var users []User
+	if err := json.NewDecoder(resp.Body).Decode(&users); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return users, nil
+}
+
+// ListGroupDataSources はグループがアクセスできるデータソースの一覧を取得
+// 各データソースの ViewOnly はこのグループでの権限を表す
+func (c *Client) ListGroupDataSources(groupID int) ([]DataSource, error) {
+	url := fmt.Sprintf("%s/api/groups/%d/data_sources", c.BaseURL, groupID)
+
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Key %s", c.APIKey))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	var dataSources []DataSource
+	if err := json.NewDecoder(resp.Body).Decode(&dataSources); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return dataSources, nil
+}
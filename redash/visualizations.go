@@ -0,0 +1,349 @@
+package redash
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// ビジュアライゼーションの種類
+const (
+	VisualizationTypeChart      = "CHART"
+	VisualizationTypeTable      = "TABLE"
+	VisualizationTypeCounter    = "COUNTER"
+	VisualizationTypePivot      = "PIVOT"
+	VisualizationTypeCohort     = "COHORT"
+	VisualizationTypeFunnel     = "FUNNEL"
+	VisualizationTypeMap        = "MAP"
+	VisualizationTypeChoropleth = "CHOROPLETH"
+	VisualizationTypeWordCloud  = "WORD_CLOUD"
+	VisualizationTypeSankey     = "SANKEY"
+	VisualizationTypeSunburst   = "SUNBURST_SEQUENCE"
+	VisualizationTypeDetails    = "DETAILS"
+)
+
+// VisualizationTypes は Redash が提供するビジュアライゼーションの種類
+var VisualizationTypes = []string{
+	VisualizationTypeChart,
+	VisualizationTypeTable,
+	VisualizationTypeCounter,
+	VisualizationTypePivot,
+	VisualizationTypeCohort,
+	VisualizationTypeFunnel,
+	VisualizationTypeMap,
+	VisualizationTypeChoropleth,
+	VisualizationTypeWordCloud,
+	VisualizationTypeSankey,
+	VisualizationTypeSunburst,
+	VisualizationTypeDetails,
+}
+
+// ChartOptions は CHART のオプション
+// 省略した項目は Redash のフロントエンドがデフォルト値で補完する
+type ChartOptions struct {
+	GlobalSeriesType string                        `json:"globalSeriesType,omitempty"` // line, column, area, pie, scatter, bubble, heatmap, box
+	ColumnMapping    map[string]string             `json:"columnMapping,omitempty"`    // カラム名 → "x", "y", "series", "yError", "size", "zVal"
+	Legend           *ChartLegend                  `json:"legend,omitempty"`
+	XAxis            *ChartAxis                    `json:"xAxis,omitempty"`
+	YAxis            []ChartAxis                   `json:"yAxis,omitempty"`
+	Series           *ChartSeries                  `json:"series,omitempty"`
+	SeriesOptions    map[string]ChartSeriesOptions `json:"seriesOptions,omitempty"`
+	SortX            *bool                         `json:"sortX,omitempty"`
+	ShowDataLabels   *bool                         `json:"showDataLabels,omitempty"`
+	NumberFormat     string                        `json:"numberFormat,omitempty"`
+	PercentFormat    string                        `json:"percentFormat,omitempty"`
+	DateTimeFormat   string                        `json:"dateTimeFormat,omitempty"`
+}
+
+// ChartLegend はチャートの凡例
+type ChartLegend struct {
+	Enabled   bool   `json:"enabled"`
+	Placement string `json:"placement,omitempty"` // "auto", "below"
+}
+
+// ChartAxis はチャートの軸
+type ChartAxis struct {
+	Type     string          `json:"type,omitempty"` // "-", "linear", "logarithmic", "datetime", "category"
+	Title    *ChartAxisTitle `json:"title,omitempty"`
+	Labels   *ChartAxisLabel `json:"labels,omitempty"`
+	Opposite bool            `json:"opposite,omitempty"`
+	RangeMin *float64        `json:"rangeMin,omitempty"`
+	RangeMax *float64        `json:"rangeMax,omitempty"`
+}
+
+// ChartAxisTitle は軸のタイトル
+type ChartAxisTitle struct {
+	Text string `json:"text"`
+}
+
+// ChartAxisLabel は軸のラベル表示
+type ChartAxisLabel struct {
+	Enabled bool `json:"enabled"`
+}
+
+// ChartSeries は系列全体の設定
+type ChartSeries struct {
+	Stacking *string `json:"stacking"` // nil: 積み上げなし, "stack": 積み上げ
+}
+
+// ChartSeriesOptions は系列ごとの設定
+type ChartSeriesOptions struct {
+	Type   string `json:"type,omitempty"`
+	Name   string `json:"name,omitempty"`
+	Color  string `json:"color,omitempty"`
+	YAxis  int    `json:"yAxis"`
+	ZIndex int    `json:"zIndex"`
+	Index  int    `json:"index"`
+}
+
+// TableOptions は TABLE のオプション
+type TableOptions struct {
+	ItemsPerPage int           `json:"itemsPerPage,omitempty"`
+	Columns      []TableColumn `json:"columns,omitempty"`
+}
+
+// TableColumn はテーブルのカラム設定
+type TableColumn struct {
+	Name             string `json:"name"`
+	Title            string `json:"title,omitempty"`
+	DisplayAs        string `json:"displayAs,omitempty"` // "string", "number", "datetime", "boolean", "json", "image", "link"
+	Visible          *bool  `json:"visible,omitempty"`
+	Order            int    `json:"order"`
+	AlignContent     string `json:"alignContent,omitempty"` // "left", "center", "right"
+	AllowSearch      bool   `json:"allowSearch,omitempty"`
+	NumberFormat     string `json:"numberFormat,omitempty"`
+	DateTimeFormat   string `json:"dateTimeFormat,omitempty"`
+	LinkURLTemplate  string `json:"linkUrlTemplate,omitempty"`
+	LinkTextTemplate string `json:"linkTextTemplate,omitempty"`
+}
+
+// CounterOptions は COUNTER のオプション
+type CounterOptions struct {
+	CounterLabel    string `json:"counterLabel,omitempty"`
+	CounterColName  string `json:"counterColName,omitempty"`
+	RowNumber       int    `json:"rowNumber,omitempty"`
+	TargetColName   string `json:"targetColName,omitempty"`
+	TargetRowNumber int    `json:"targetRowNumber,omitempty"`
+	CountRow        bool   `json:"countRow,omitempty"`
+	StringDecimal   int    `json:"stringDecimal,omitempty"`
+	StringDecChar   string `json:"stringDecChar,omitempty"`
+	StringThouSep   string `json:"stringThouSep,omitempty"`
+	StringPrefix    string `json:"stringPrefix,omitempty"`
+	StringSuffix    string `json:"stringSuffix,omitempty"`
+}
+
+// PivotOptions は PIVOT のオプション
+type PivotOptions struct {
+	Controls       *PivotControls `json:"controls,omitempty"`
+	Rows           []string       `json:"rows,omitempty"`
+	Cols           []string       `json:"cols,omitempty"`
+	Vals           []string       `json:"vals,omitempty"`
+	AggregatorName string         `json:"aggregatorName,omitempty"` // "Count", "Sum", "Average" など
+	RendererName   string         `json:"rendererName,omitempty"`   // "Table", "Heatmap" など
+}
+
+// PivotControls はピボットテーブルの操作パネル
+type PivotControls struct {
+	Enabled bool `json:"enabled"`
+}
+
+// FunnelOptions は FUNNEL のオプション
+type FunnelOptions struct {
+	StepCol            *FunnelColumn `json:"stepCol,omitempty"`
+	ValueCol           *FunnelColumn `json:"valueCol,omitempty"`
+	AutoSort           *bool         `json:"autoSort,omitempty"`
+	ItemsLimit         int           `json:"itemsLimit,omitempty"`
+	NumberFormat       string        `json:"numberFormat,omitempty"`
+	PercentFormat      string        `json:"percentFormat,omitempty"`
+	PercentValuesRange *FunnelRange  `json:"percentValuesRange,omitempty"`
+}
+
+// FunnelColumn はファネルのカラム指定
+type FunnelColumn struct {
+	ColName   string `json:"colName"`
+	DisplayAs string `json:"displayAs,omitempty"`
+}
+
+// FunnelRange はファネルのパーセント表示範囲
+type FunnelRange struct {
+	Min float64 `json:"min"`
+	Max float64 `json:"max"`
+}
+
+// CohortOptions は COHORT のオプション
+type CohortOptions struct {
+	TimeInterval string `json:"timeInterval,omitempty"` // "daily", "weekly", "monthly"
+	Mode         string `json:"mode,omitempty"`         // "diagonal", "simple"
+	DateColumn   string `json:"dateColumn,omitempty"`
+	StageColumn  string `json:"stageColumn,omitempty"`
+	TotalColumn  string `json:"totalColumn,omitempty"`
+	ValueColumn  string `json:"valueColumn,omitempty"`
+}
+
+// ValidateVisualizationOptions はオプションが種類ごとの型に合っているか検証
+// 型が定義されていない種類は検証せずに受け付ける
+func ValidateVisualizationOptions(visualizationType string, options map[string]interface{}) error {
+	var target interface{}
+	switch visualizationType {
+	case VisualizationTypeChart:
+		target = &ChartOptions{}
+	case VisualizationTypeTable:
+		target = &TableOptions{}
+	case VisualizationTypeCounter:
+		target = &CounterOptions{}
+	case VisualizationTypePivot:
+		target = &PivotOptions{}
+	case VisualizationTypeFunnel:
+		target = &FunnelOptions{}
+	case VisualizationTypeCohort:
+		target = &CohortOptions{}
+	default:
+		return nil
+	}
+
+	data, err := json.Marshal(options)
+	if err != nil {
+		return fmt.Errorf("failed to marshal options: %w", err)
+	}
+
+	if err := json.Unmarshal(data, target); err != nil {
+		return fmt.Errorf("invalid %s options: %w", visualizationType, err)
+	}
+
+	return nil
+}
+
+// CreateVisualizationInput はビジュアライゼーション作成時の入力
+type CreateVisualizationInput struct {
+	QueryID     int                    `json:"query_id"`
+	Type        string                 `json:"type"`
+	Name        string                 `json:"name"`
+	Description string                 `json:"description,omitempty"`
+	Options     map[string]interface{} `json:"options"`
+}
+
+// UpdateVisualizationInput はビジュアライゼーション更新時の入力
+// nil のフィールドは送信せず、Redash 側の値を変更しない
+type UpdateVisualizationInput struct {
+	Type        *string                `json:"type,omitempty"`
+	Name        *string                `json:"name,omitempty"`
+	Description *string                `json:"description,omitempty"`
+	Options     map[string]interface{} `json:"options,omitempty"`
+}
+
+// GetVisualization はビジュアライゼーションを取得
+// Redash に単体取得の API はないため、クエリに含まれるビジュアライゼーションから探す
+func (c *Client) GetVisualization(queryID, visualizationID int) (*Visualization, error) {
+	query, err := c.GetQuery(queryID)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range query.Visualizations {
+		if query.Visualizations[i].ID == visualizationID {
+			return &query.Visualizations[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("visualization %d not found in query %d", visualizationID, queryID)
+}
+
+// CreateVisualization はクエリに新しいビジュアライゼーションを追加
+func (c *Client) CreateVisualization(input CreateVisualizationInput) (*Visualization, error) {
+	url := fmt.Sprintf("%s/api/visualizations", c.BaseURL)
+
+	data, err := json.Marshal(input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequest("POST", url, bytes.NewBuffer(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Key %s", c.APIKey))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	var visualization Visualization
+	if err := json.NewDecoder(resp.Body).Decode(&visualization); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &visualization, nil
+}
+
+// UpdateVisualization は既存のビジュアライゼーションを更新
+func (c *Client) UpdateVisualization(visualizationID int, input UpdateVisualizationInput) (*Visualization, error) {
+	url := fmt.Sprintf("%s/api/visualizations/%d", c.BaseURL, visualizationID)
+
+	data, err := json.Marshal(input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequest("POST", url, bytes.NewBuffer(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Key %s", c.APIKey))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	var visualization Visualization
+	if err := json.NewDecoder(resp.Body).Decode(&visualization); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &visualization, nil
+}
+
+// DeleteVisualization はビジュアライゼーションを削除
+// ダッシュボード上でこのビジュアライゼーションを表示しているウィジェットも削除される
+func (c *Client) DeleteVisualization(visualizationID int) error {
+	url := fmt.Sprintf("%s/api/visualizations/%d", c.BaseURL, visualizationID)
+
+	req, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("Key %s", c.APIKey))
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	return nil
+}
@@ -0,0 +1,228 @@
+package tools
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/shshimamo/redash-mcp-go/mcp"
+	"github.com/shshimamo/redash-mcp-go/redash"
+)
+
+// queueStatus は get_queue_status の結果
+type queueStatus struct {
+	Queues      []queueSummary    `json:"queues"`
+	RunningJobs []runningJob      `json:"running_jobs"` // 実行時間の長い順
+	Workers     []redash.RQWorker `json:"workers"`
+}
+
+// queueSummary はジョブキューごとのジョブ数
+type queueSummary struct {
+	Name    string `json:"name"`
+	Running int    `json:"running"`
+	Queued  int    `json:"queued"`
+}
+
+// runningJob は実行中のジョブ
+type runningJob struct {
+	JobID          string      `json:"job_id"`
+	Queue          string      `json:"queue"`
+	Name           string      `json:"name"`
+	QueryID        interface{} `json:"query_id,omitempty"`
+	DataSourceID   interface{} `json:"data_source_id,omitempty"`
+	UserID         interface{} `json:"user_id,omitempty"`
+	Scheduled      interface{} `json:"scheduled,omitempty"`
+	StartedAt      string      `json:"started_at"`
+	RuntimeSeconds *float64    `json:"runtime_seconds,omitempty"`
+}
+
+// outdatedQueries は list_outdated_queries の結果
+type outdatedQueries struct {
+	UpdatedAt interface{}     `json:"updated_at"`
+	Count     int             `json:"count"`
+	Queries   []outdatedQuery `json:"queries"`
+}
+
+// outdatedQuery は更新が遅れているクエリ
+type outdatedQuery struct {
+	ID           int                   `json:"id"`
+	Name         string                `json:"name"`
+	DataSourceID int                   `json:"data_source_id"`
+	Schedule     *redash.QuerySchedule `json:"schedule"`
+	RetrievedAt  string                `json:"retrieved_at,omitempty"`
+	Runtime      *float64              `json:"runtime,omitempty"`
+	Owner        string                `json:"owner,omitempty"`
+}
+
+// getQueueStatus はジョブキュー・実行中のジョブ・ワーカーの状態を取得
+func (h *Handler) getQueueStatus(args map[string]interface{}) mcp.CallToolResult {
+	// min_runtime_seconds の取得（オプション）
+	var minRuntime float64
+	if value, exists := args["min_runtime_seconds"]; exists {
+		minRuntimeFloat, ok := value.(float64)
+		if !ok || minRuntimeFloat < 0 {
+			return mcp.CallToolResult{
+				Content: []mcp.Content{
+					{
+						Type: "text",
+						Text: "min_runtime_seconds must be a non-negative number",
+					},
+				},
+				IsError: true,
+			}
+		}
+		minRuntime = minRuntimeFloat
+	}
+
+	// Redash API を呼び出し
+	status, err := h.redashClient.GetRQStatus()
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to get queue status: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	result := queueStatus{
+		Queues:      []queueSummary{},
+		RunningJobs: []runningJob{},
+		Workers:     status.Workers,
+	}
+	if result.Workers == nil {
+		result.Workers = []redash.RQWorker{}
+	}
+
+	now := time.Now()
+	for _, queue := range status.Queues {
+		result.Queues = append(result.Queues, queueSummary{
+			Name:    queue.Name,
+			Running: len(queue.Started),
+			Queued:  queue.Queued,
+		})
+
+		for _, job := range queue.Started {
+			running := runningJob{
+				JobID:        job.ID,
+				Queue:        queue.Name,
+				Name:         job.Name,
+				QueryID:      job.Meta["query_id"],
+				DataSourceID: job.Meta["data_source_id"],
+				UserID:       job.Meta["user_id"],
+				Scheduled:    job.Meta["scheduled"],
+				StartedAt:    job.StartedAt,
+			}
+			if runtime, err := job.Runtime(now); err == nil {
+				seconds := math.Round(runtime.Seconds())
+				running.RuntimeSeconds = &seconds
+			}
+			// 実行時間が分からないジョブは絞り込みの対象外として残す
+			if running.RuntimeSeconds != nil && *running.RuntimeSeconds < minRuntime {
+				continue
+			}
+			result.RunningJobs = append(result.RunningJobs, running)
+		}
+	}
+
+	sort.Slice(result.Queues, func(i, j int) bool {
+		return result.Queues[i].Name < result.Queues[j].Name
+	})
+	sort.SliceStable(result.RunningJobs, func(i, j int) bool {
+		a, b := result.RunningJobs[i].RuntimeSeconds, result.RunningJobs[j].RuntimeSeconds
+		if a == nil || b == nil {
+			return a != nil
+		}
+		return *a > *b
+	})
+
+	// JSON として整形して返す
+	formatted, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to format queue status: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	return mcp.CallToolResult{
+		Content: []mcp.Content{
+			{
+				Type: "text",
+				Text: string(formatted),
+			},
+		},
+		IsError: false,
+	}
+}
+
+// listOutdatedQueries は定期実行の更新が遅れているクエリの一覧を取得
+func (h *Handler) listOutdatedQueries(args map[string]interface{}) mcp.CallToolResult {
+	// Redash API を呼び出し
+	outdated, err := h.redashClient.GetOutdatedQueries()
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to get outdated queries: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	result := outdatedQueries{
+		UpdatedAt: outdated.UpdatedAt,
+		Count:     len(outdated.Queries),
+		Queries:   make([]outdatedQuery, 0, len(outdated.Queries)),
+	}
+	for _, query := range outdated.Queries {
+		item := outdatedQuery{
+			ID:           query.ID,
+			Name:         query.Name,
+			DataSourceID: query.DataSourceID,
+			Schedule:     query.Schedule,
+			RetrievedAt:  query.RetrievedAt,
+			Runtime:      query.Runtime,
+		}
+		if query.User != nil {
+			item.Owner = query.User.Name
+		}
+		result.Queries = append(result.Queries, item)
+	}
+
+	// JSON として整形して返す
+	formatted, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to format outdated queries: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	return mcp.CallToolResult{
+		Content: []mcp.Content{
+			{
+				Type: "text",
+				Text: string(formatted),
+			},
+		},
+		IsError: false,
+	}
+}
@@ -0,0 +1,530 @@
+package tools
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/shshimamo/redash-mcp-go/mcp"
+	"github.com/shshimamo/redash-mcp-go/redash"
+)
+
+// alertOps はアラート条件で使える比較演算子
+var alertOps = []string{">", ">=", "<", "<=", "==", "!="}
+
+// alertSummary は list_alerts で返すアラートの要約
+type alertSummary struct {
+	ID              int                 `json:"id"`
+	Name            string              `json:"name"`
+	State           string              `json:"state"`
+	QueryID         int                 `json:"query_id,omitempty"`
+	QueryName       string              `json:"query_name,omitempty"`
+	Options         redash.AlertOptions `json:"options"`
+	Rearm           *int                `json:"rearm"`
+	LastTriggeredAt string              `json:"last_triggered_at,omitempty"`
+}
+
+// listAlerts はアラートの一覧を取得
+func (h *Handler) listAlerts(args map[string]interface{}) mcp.CallToolResult {
+	// Redash API を呼び出し
+	alerts, err := h.redashClient.ListAlerts()
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to list alerts: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	// クエリの SQL まで含めると大きくなるため要約して返す
+	summaries := make([]alertSummary, 0, len(alerts))
+	for _, alert := range alerts {
+		summary := alertSummary{
+			ID:              alert.ID,
+			Name:            alert.Name,
+			State:           alert.State,
+			Options:         alert.Options,
+			Rearm:           alert.Rearm,
+			LastTriggeredAt: alert.LastTriggeredAt,
+		}
+		if alert.Query != nil {
+			summary.QueryID = alert.Query.ID
+			summary.QueryName = alert.Query.Name
+		}
+		summaries = append(summaries, summary)
+	}
+
+	// JSON として整形して返す
+	formatted, err := json.MarshalIndent(summaries, "", "  ")
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to format alerts: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	return mcp.CallToolResult{
+		Content: []mcp.Content{
+			{
+				Type: "text",
+				Text: string(formatted),
+			},
+		},
+		IsError: false,
+	}
+}
+
+// createAlert は新しいアラートを作成
+func (h *Handler) createAlert(args map[string]interface{}) mcp.CallToolResult {
+	// name の取得
+	name, ok := args["name"].(string)
+	if !ok || name == "" {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: "name must be a non-empty string",
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	// query_id の取得
+	queryIDFloat, ok := args["query_id"].(float64)
+	if !ok {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: "query_id must be a number",
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	// column, op, value の取得
+	options, errMsg := parseAlertOptions(args, redash.AlertOptions{})
+	if errMsg == "" && (options.Column == "" || options.Op == "" || options.Value == nil) {
+		errMsg = "column, op and value must be specified"
+	}
+	if errMsg != "" {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: errMsg,
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	input := redash.CreateAlertInput{
+		Name:    name,
+		QueryID: int(queryIDFloat),
+		Options: options,
+	}
+
+	// rearm の取得（オプション）
+	if value, exists := args["rearm"]; exists {
+		rearmFloat, ok := value.(float64)
+		if !ok || rearmFloat < 0 {
+			return mcp.CallToolResult{
+				Content: []mcp.Content{
+					{
+						Type: "text",
+						Text: "rearm must be a non-negative number of seconds",
+					},
+				},
+				IsError: true,
+			}
+		}
+		rearm := int(rearmFloat)
+		input.Rearm = &rearm
+	}
+
+	// Redash API を呼び出し
+	alert, err := h.redashClient.CreateAlert(input)
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to create alert: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	// JSON として整形して返す
+	formatted, err := json.MarshalIndent(alert, "", "  ")
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to format alert: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	return mcp.CallToolResult{
+		Content: []mcp.Content{
+			{
+				Type: "text",
+				Text: string(formatted),
+			},
+		},
+		IsError: false,
+	}
+}
+
+// updateAlert は既存のアラートを更新
+func (h *Handler) updateAlert(args map[string]interface{}) mcp.CallToolResult {
+	// alert_id の取得
+	alertIDFloat, ok := args["alert_id"].(float64)
+	if !ok {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: "alert_id must be a number",
+				},
+			},
+			IsError: true,
+		}
+	}
+	alertID := int(alertIDFloat)
+
+	// Redash は options を丸ごと置き換えるため、現在のアラートを取得
+	current, err := h.redashClient.GetAlert(alertID)
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to get alert: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	var input redash.UpdateAlertInput
+
+	// name の取得（オプション）
+	if name, ok := args["name"].(string); ok {
+		input.Name = &name
+	}
+
+	// query_id の取得（オプション）
+	if queryIDFloat, ok := args["query_id"].(float64); ok {
+		queryID := int(queryIDFloat)
+		input.QueryID = &queryID
+	}
+
+	// column, op, value などの取得（オプション）
+	options, errMsg := mergeAlertOptions(args, current.RawOptions)
+	if errMsg != "" {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: errMsg,
+				},
+			},
+			IsError: true,
+		}
+	}
+	input.Options = options
+
+	// rearm の取得（オプション）
+	if value, exists := args["rearm"]; exists {
+		rearmFloat, ok := value.(float64)
+		if !ok || rearmFloat < 0 {
+			return mcp.CallToolResult{
+				Content: []mcp.Content{
+					{
+						Type: "text",
+						Text: "rearm must be a non-negative number of seconds",
+					},
+				},
+				IsError: true,
+			}
+		}
+		rearm := int(rearmFloat)
+		input.Rearm = &rearm
+	}
+
+	log.Printf("Updating alert %d (%s)", current.ID, current.Name)
+
+	// Redash API を呼び出し
+	alert, err := h.redashClient.UpdateAlert(alertID, input)
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to update alert: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	// JSON として整形して返す
+	formatted, err := json.MarshalIndent(alert, "", "  ")
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to format alert: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	return mcp.CallToolResult{
+		Content: []mcp.Content{
+			{
+				Type: "text",
+				Text: string(formatted),
+			},
+		},
+		IsError: false,
+	}
+}
+
+// muteAlert はアラートの通知を停止
+func (h *Handler) muteAlert(args map[string]interface{}) mcp.CallToolResult {
+	// alert_id の取得
+	alertIDFloat, ok := args["alert_id"].(float64)
+	if !ok {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: "alert_id must be a number",
+				},
+			},
+			IsError: true,
+		}
+	}
+	alertID := int(alertIDFloat)
+
+	log.Printf("Muting alert %d", alertID)
+
+	// Redash API を呼び出し
+	if err := h.redashClient.MuteAlert(alertID); err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to mute alert: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	return mcp.CallToolResult{
+		Content: []mcp.Content{
+			{
+				Type: "text",
+				Text: fmt.Sprintf("Alert %d muted", alertID),
+			},
+		},
+		IsError: false,
+	}
+}
+
+// unmuteAlert はアラートの通知を再開
+func (h *Handler) unmuteAlert(args map[string]interface{}) mcp.CallToolResult {
+	// alert_id の取得
+	alertIDFloat, ok := args["alert_id"].(float64)
+	if !ok {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: "alert_id must be a number",
+				},
+			},
+			IsError: true,
+		}
+	}
+	alertID := int(alertIDFloat)
+
+	log.Printf("Unmuting alert %d", alertID)
+
+	// Redash API を呼び出し
+	if err := h.redashClient.UnmuteAlert(alertID); err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to unmute alert: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	return mcp.CallToolResult{
+		Content: []mcp.Content{
+			{
+				Type: "text",
+				Text: fmt.Sprintf("Alert %d unmuted", alertID),
+			},
+		},
+		IsError: false,
+	}
+}
+
+// deleteAlert はアラートを削除
+func (h *Handler) deleteAlert(args map[string]interface{}) mcp.CallToolResult {
+	// alert_id の取得
+	alertIDFloat, ok := args["alert_id"].(float64)
+	if !ok {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: "alert_id must be a number",
+				},
+			},
+			IsError: true,
+		}
+	}
+	alertID := int(alertIDFloat)
+
+	log.Printf("Deleting alert %d", alertID)
+
+	// Redash API を呼び出し
+	if err := h.redashClient.DeleteAlert(alertID); err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to delete alert: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	return mcp.CallToolResult{
+		Content: []mcp.Content{
+			{
+				Type: "text",
+				Text: fmt.Sprintf("Alert %d deleted", alertID),
+			},
+		},
+		IsError: false,
+	}
+}
+
+// parseAlertOptions は column, op, value, custom_subject, custom_body の引数で条件を上書き
+// 不正な引数がある場合はエラーメッセージを返す
+func parseAlertOptions(args map[string]interface{}, options redash.AlertOptions) (redash.AlertOptions, string) {
+	if value, exists := args["column"]; exists {
+		column, ok := value.(string)
+		if !ok || column == "" {
+			return options, "column must be a non-empty string"
+		}
+		options.Column = column
+	}
+
+	if value, exists := args["op"]; exists {
+		op, ok := value.(string)
+		valid := false
+		for _, alertOp := range alertOps {
+			if op == alertOp {
+				valid = true
+				break
+			}
+		}
+		if !ok || !valid {
+			return options, fmt.Sprintf("op must be one of: %s", strings.Join(alertOps, ", "))
+		}
+		options.Op = op
+	}
+
+	if value, exists := args["value"]; exists {
+		switch value.(type) {
+		case float64, string:
+			options.Value = value
+		default:
+			return options, "value must be a number or a string"
+		}
+	}
+
+	if value, exists := args["custom_subject"]; exists {
+		customSubject, ok := value.(string)
+		if !ok {
+			return options, "custom_subject must be a string"
+		}
+		options.CustomSubject = customSubject
+	}
+
+	if value, exists := args["custom_body"]; exists {
+		customBody, ok := value.(string)
+		if !ok {
+			return options, "custom_body must be a string"
+		}
+		options.CustomBody = customBody
+	}
+
+	return options, ""
+}
+
+// mergeAlertOptions は column, op, value, custom_subject, custom_body の引数を現在の options にマージ
+// AlertOptions に含まれないキー（selector など）を消さないよう、Redash から取得した options をそのまま使う
+// いずれの引数も指定されていない場合は nil を返し、options を送信しない
+func mergeAlertOptions(args map[string]interface{}, current map[string]interface{}) (map[string]interface{}, string) {
+	parsed, errMsg := parseAlertOptions(args, redash.AlertOptions{})
+	if errMsg != "" {
+		return nil, errMsg
+	}
+
+	values := map[string]interface{}{
+		"column":         parsed.Column,
+		"op":             parsed.Op,
+		"value":          parsed.Value,
+		"custom_subject": parsed.CustomSubject,
+		"custom_body":    parsed.CustomBody,
+	}
+
+	var merged map[string]interface{}
+	for _, key := range []string{"column", "op", "value", "custom_subject", "custom_body"} {
+		if _, exists := args[key]; !exists {
+			continue
+		}
+		if merged == nil {
+			merged = make(map[string]interface{}, len(current)+1)
+			for k, v := range current {
+				merged[k] = v
+			}
+		}
+		merged[key] = values[key]
+	}
+
+	return merged, ""
+}
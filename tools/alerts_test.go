@@ -0,0 +1,68 @@
+package tools
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/shshimamo/redash-mcp-go/redash"
+)
+
+func TestUpdateAlertOptions(t *testing.T) {
+	current := `{"id": 1, "name": "Errors", "state": "ok", "rearm": null,
+		"options": {"column": "count", "op": ">", "value": 10, "selector": "first", "muted": false}}`
+
+	tests := []struct {
+		name        string
+		args        map[string]interface{}
+		wantOptions map[string]interface{}
+	}{
+		{
+			name:        "name only",
+			args:        map[string]interface{}{"alert_id": float64(1), "name": "Renamed"},
+			wantOptions: nil,
+		},
+		{
+			name: "value",
+			args: map[string]interface{}{"alert_id": float64(1), "value": float64(20)},
+			wantOptions: map[string]interface{}{
+				"column": "count", "op": ">", "value": float64(20), "selector": "first", "muted": false,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var body map[string]interface{}
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == "POST" {
+					data, _ := io.ReadAll(r.Body)
+					if err := json.Unmarshal(data, &body); err != nil {
+						t.Errorf("invalid request body: %v", err)
+					}
+				}
+				io.WriteString(w, current)
+			}))
+			defer srv.Close()
+
+			h := &Handler{redashClient: redash.NewClient(srv.URL, "key", true)}
+			if result := h.updateAlert(tt.args); result.IsError {
+				t.Fatalf("updateAlert() error = %s", result.Content[0].Text)
+			}
+
+			options, sent := body["options"]
+			if tt.wantOptions == nil {
+				if sent {
+					t.Errorf("options = %v, want not sent", options)
+				}
+				return
+			}
+			if !reflect.DeepEqual(options, tt.wantOptions) {
+				t.Errorf("options = %v, want %v", options, tt.wantOptions)
+			}
+		})
+	}
+}
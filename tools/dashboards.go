@@ -0,0 +1,905 @@
+package tools
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+
+	"github.com/shshimamo/redash-mcp-go/mcp"
+	"github.com/shshimamo/redash-mcp-go/redash"
+)
+
+// listDashboards はダッシュボードの一覧を取得
+func (h *Handler) listDashboards(args map[string]interface{}) mcp.CallToolResult {
+	var opts redash.DashboardListOptions
+
+	// page の取得（オプション）
+	if value, exists := args["page"]; exists {
+		page, ok := value.(float64)
+		if !ok || page < 1 {
+			return mcp.CallToolResult{
+				Content: []mcp.Content{
+					{
+						Type: "text",
+						Text: "page must be a positive number",
+					},
+				},
+				IsError: true,
+			}
+		}
+		opts.Page = int(page)
+	}
+
+	// page_size の取得（オプション）
+	if value, exists := args["page_size"]; exists {
+		pageSize, ok := value.(float64)
+		if !ok || pageSize < 1 {
+			return mcp.CallToolResult{
+				Content: []mcp.Content{
+					{
+						Type: "text",
+						Text: "page_size must be a positive number",
+					},
+				},
+				IsError: true,
+			}
+		}
+		opts.PageSize = int(pageSize)
+	}
+
+	// q の取得（オプション）
+	if q, ok := args["q"].(string); ok {
+		opts.Search = q
+	}
+
+	// tags の取得（オプション）
+	if value, exists := args["tags"]; exists {
+		tags, ok := toStringSlice(value)
+		if !ok {
+			return mcp.CallToolResult{
+				Content: []mcp.Content{
+					{
+						Type: "text",
+						Text: "tags must be an array of strings",
+					},
+				},
+				IsError: true,
+			}
+		}
+		opts.Tags = tags
+	}
+
+	// favorites_only の取得（オプション）
+	opts.FavoritesOnly, _ = args["favorites_only"].(bool)
+
+	// include_widget_counts の取得（オプション）
+	includeWidgetCounts, _ := args["include_widget_counts"].(bool)
+
+	// Redash API を呼び出し
+	list, err := h.redashClient.ListDashboards(opts)
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to list dashboards: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	// 一覧 API はウィジェットを返さないため、各ダッシュボードを取得して数える
+	// 取得に失敗したダッシュボードはウィジェット数の代わりにエラーを返す
+	if includeWidgetCounts {
+		for i := range list.Results {
+			dashboard, err := h.redashClient.GetDashboard(list.Results[i].ID)
+			if err != nil {
+				list.Results[i].WidgetCountError = err.Error()
+				continue
+			}
+			widgetCount := len(dashboard.Widgets)
+			list.Results[i].WidgetCount = &widgetCount
+		}
+	}
+
+	// JSON として整形して返す
+	formatted, err := json.MarshalIndent(list, "", "  ")
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to format dashboards: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	return mcp.CallToolResult{
+		Content: []mcp.Content{
+			{
+				Type: "text",
+				Text: string(formatted),
+			},
+		},
+		IsError: false,
+	}
+}
+
+// archiveDashboard はダッシュボードをアーカイブ
+func (h *Handler) archiveDashboard(args map[string]interface{}) mcp.CallToolResult {
+	// dashboard_id の取得
+	dashboardIDFloat, ok := args["dashboard_id"].(float64)
+	if !ok {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: "dashboard_id must be a number",
+				},
+			},
+			IsError: true,
+		}
+	}
+	dashboardID := int(dashboardIDFloat)
+
+	// 削除 API は slug で指定するため、先にダッシュボードを取得
+	dashboard, err := h.redashClient.GetDashboard(dashboardID)
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to get dashboard: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	log.Printf("Archiving dashboard %d (%s)", dashboard.ID, dashboard.Name)
+
+	// Redash API を呼び出し
+	if err := h.redashClient.ArchiveDashboard(dashboard.Slug); err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to archive dashboard: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	// JSON として整形して返す
+	formatted, err := json.MarshalIndent(archiveResult{
+		ID:         dashboard.ID,
+		Name:       dashboard.Name,
+		IsArchived: true,
+	}, "", "  ")
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to format result: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	return mcp.CallToolResult{
+		Content: []mcp.Content{
+			{
+				Type: "text",
+				Text: string(formatted),
+			},
+		},
+		IsError: false,
+	}
+}
+
+// unarchiveDashboard はアーカイブ済みのダッシュボードを元に戻す
+func (h *Handler) unarchiveDashboard(args map[string]interface{}) mcp.CallToolResult {
+	// dashboard_id の取得
+	dashboardIDFloat, ok := args["dashboard_id"].(float64)
+	if !ok {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: "dashboard_id must be a number",
+				},
+			},
+			IsError: true,
+		}
+	}
+	dashboardID := int(dashboardIDFloat)
+
+	log.Printf("Unarchiving dashboard %d", dashboardID)
+
+	// Redash API を呼び出し
+	dashboard, err := h.redashClient.UnarchiveDashboard(dashboardID)
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to unarchive dashboard: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	// JSON として整形して返す
+	formatted, err := json.MarshalIndent(archiveResult{
+		ID:         dashboard.ID,
+		Name:       dashboard.Name,
+		IsArchived: dashboard.IsArchived,
+	}, "", "  ")
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to format result: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	return mcp.CallToolResult{
+		Content: []mcp.Content{
+			{
+				Type: "text",
+				Text: string(formatted),
+			},
+		},
+		IsError: false,
+	}
+}
+
+// createDashboard は新しいダッシュボードを作成
+func (h *Handler) createDashboard(args map[string]interface{}) mcp.CallToolResult {
+	// name の取得
+	name, ok := args["name"].(string)
+	if !ok || name == "" {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: "name must be a non-empty string",
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	// Redash API を呼び出し
+	dashboard, err := h.redashClient.CreateDashboard(name)
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to create dashboard: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	// publish が指定された場合は下書きを解除
+	if publish, _ := args["publish"].(bool); publish {
+		isDraft := false
+		dashboard, err = h.redashClient.UpdateDashboard(dashboard.ID, redash.UpdateDashboardInput{IsDraft: &isDraft})
+		if err != nil {
+			return mcp.CallToolResult{
+				Content: []mcp.Content{
+					{
+						Type: "text",
+						Text: fmt.Sprintf("Dashboard was created but failed to publish: %v", err),
+					},
+				},
+				IsError: true,
+			}
+		}
+	}
+
+	// JSON として整形して返す
+	formatted, err := json.MarshalIndent(dashboard, "", "  ")
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to format dashboard: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	return mcp.CallToolResult{
+		Content: []mcp.Content{
+			{
+				Type: "text",
+				Text: string(formatted),
+			},
+		},
+		IsError: false,
+	}
+}
+
+// addWidget はダッシュボードにビジュアライゼーションまたはテキストのウィジェットを追加
+func (h *Handler) addWidget(args map[string]interface{}) mcp.CallToolResult {
+	// dashboard_id の取得
+	dashboardIDFloat, ok := args["dashboard_id"].(float64)
+	if !ok {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: "dashboard_id must be a number",
+				},
+			},
+			IsError: true,
+		}
+	}
+	dashboardID := int(dashboardIDFloat)
+
+	input := redash.CreateWidgetInput{
+		DashboardID: dashboardID,
+		Width:       1,
+	}
+
+	// visualization_id または text の取得
+	if visualizationIDFloat, ok := args["visualization_id"].(float64); ok {
+		visualizationID := int(visualizationIDFloat)
+		input.VisualizationID = &visualizationID
+	}
+	if text, ok := args["text"].(string); ok {
+		input.Text = text
+	}
+	if input.VisualizationID == nil && input.Text == "" {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: "either visualization_id or text must be specified",
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	// 既存のウィジェットの下に配置するため、ダッシュボードを取得
+	dashboard, err := h.redashClient.GetDashboard(dashboardID)
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to get dashboard: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	bottom := 0
+	for _, widget := range dashboard.Widgets {
+		if widget.Options.Position != nil && widget.Options.Position.Row+widget.Options.Position.SizeY > bottom {
+			bottom = widget.Options.Position.Row + widget.Options.Position.SizeY
+		}
+	}
+
+	// デフォルトの位置とサイズ（テキストは全幅・低め、ビジュアライゼーションは半幅）
+	position := redash.WidgetPosition{
+		Col:   0,
+		Row:   bottom,
+		SizeX: 3,
+		SizeY: 8,
+	}
+	if input.VisualizationID == nil {
+		position.SizeX = 6
+		position.SizeY = 3
+	}
+
+	position, errMsg := parseWidgetPosition(args, position)
+	if errMsg != "" {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: errMsg,
+				},
+			},
+			IsError: true,
+		}
+	}
+	input.Options.Position = &position
+
+	// Redash API を呼び出し
+	widget, err := h.redashClient.CreateWidget(input)
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to add widget: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	// JSON として整形して返す
+	formatted, err := json.MarshalIndent(widget, "", "  ")
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to format widget: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	return mcp.CallToolResult{
+		Content: []mcp.Content{
+			{
+				Type: "text",
+				Text: string(formatted),
+			},
+		},
+		IsError: false,
+	}
+}
+
+// updateWidget はウィジェットのテキスト、位置、サイズを変更
+func (h *Handler) updateWidget(args map[string]interface{}) mcp.CallToolResult {
+	// dashboard_id の取得
+	dashboardIDFloat, ok := args["dashboard_id"].(float64)
+	if !ok {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: "dashboard_id must be a number",
+				},
+			},
+			IsError: true,
+		}
+	}
+	dashboardID := int(dashboardIDFloat)
+
+	// widget_id の取得
+	widgetIDFloat, ok := args["widget_id"].(float64)
+	if !ok {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: "widget_id must be a number",
+				},
+			},
+			IsError: true,
+		}
+	}
+	widgetID := int(widgetIDFloat)
+
+	// Redash は text と options を丸ごと置き換えるため、現在のウィジェットを取得
+	dashboard, err := h.redashClient.GetDashboard(dashboardID)
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to get dashboard: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	var current *redash.Widget
+	for i := range dashboard.Widgets {
+		if dashboard.Widgets[i].ID == widgetID {
+			current = &dashboard.Widgets[i]
+			break
+		}
+	}
+	if current == nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("widget %d not found in dashboard %d", widgetID, dashboardID),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	// WidgetOptions に含まれないキーを消さないよう、Redash から取得した options をそのまま使う
+	input := redash.UpdateWidgetInput{
+		Text:    current.Text,
+		Options: make(map[string]interface{}, len(current.RawOptions)+1),
+	}
+	for key, value := range current.RawOptions {
+		input.Options[key] = value
+	}
+
+	// text の取得（オプション）
+	if text, ok := args["text"].(string); ok {
+		input.Text = text
+	}
+
+	// 位置とサイズの取得（オプション）
+	var position redash.WidgetPosition
+	if current.Options.Position != nil {
+		position = *current.Options.Position
+	}
+	position, errMsg := parseWidgetPosition(args, position)
+	if errMsg != "" {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: errMsg,
+				},
+			},
+			IsError: true,
+		}
+	}
+	input.Options["position"] = position
+
+	// Redash API を呼び出し
+	widget, err := h.redashClient.UpdateWidget(widgetID, input)
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to update widget: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	// JSON として整形して返す
+	formatted, err := json.MarshalIndent(widget, "", "  ")
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to format widget: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	return mcp.CallToolResult{
+		Content: []mcp.Content{
+			{
+				Type: "text",
+				Text: string(formatted),
+			},
+		},
+		IsError: false,
+	}
+}
+
+// removeWidget はダッシュボードからウィジェットを削除
+func (h *Handler) removeWidget(args map[string]interface{}) mcp.CallToolResult {
+	// widget_id の取得
+	widgetIDFloat, ok := args["widget_id"].(float64)
+	if !ok {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: "widget_id must be a number",
+				},
+			},
+			IsError: true,
+		}
+	}
+	widgetID := int(widgetIDFloat)
+
+	log.Printf("Removing widget %d", widgetID)
+
+	// Redash API を呼び出し
+	if err := h.redashClient.DeleteWidget(widgetID); err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to remove widget: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	return mcp.CallToolResult{
+		Content: []mcp.Content{
+			{
+				Type: "text",
+				Text: fmt.Sprintf("Widget %d removed", widgetID),
+			},
+		},
+		IsError: false,
+	}
+}
+
+// parseWidgetPosition は col, row, size_x, size_y, auto_height の引数で位置を上書き
+// ダッシュボードのグリッドは横6列のため、はみ出す指定はエラーメッセージを返す
+func parseWidgetPosition(args map[string]interface{}, position redash.WidgetPosition) (redash.WidgetPosition, string) {
+	if value, exists := args["col"]; exists {
+		col, ok := value.(float64)
+		if !ok || col < 0 || col > 5 {
+			return position, "col must be a number between 0 and 5"
+		}
+		position.Col = int(col)
+	}
+
+	if value, exists := args["row"]; exists {
+		row, ok := value.(float64)
+		if !ok || row < 0 {
+			return position, "row must be a non-negative number"
+		}
+		position.Row = int(row)
+	}
+
+	if value, exists := args["size_x"]; exists {
+		sizeX, ok := value.(float64)
+		if !ok || sizeX < 1 || sizeX > 6 {
+			return position, "size_x must be a number between 1 and 6"
+		}
+		position.SizeX = int(sizeX)
+	}
+
+	if value, exists := args["size_y"]; exists {
+		sizeY, ok := value.(float64)
+		if !ok || sizeY < 1 {
+			return position, "size_y must be a positive number"
+		}
+		position.SizeY = int(sizeY)
+	}
+
+	if autoHeight, ok := args["auto_height"].(bool); ok {
+		position.AutoHeight = autoHeight
+	}
+
+	if position.Col+position.SizeX > 6 {
+		return position, fmt.Sprintf("widget does not fit in the 6-column grid (col %d + size_x %d > 6)", position.Col, position.SizeX)
+	}
+
+	return position, ""
+}
+
+// ダッシュボードのウィジェットのクエリを同時に実行する数の上限
+const maxConcurrentWidgetQueries = 4
+
+// dashboardWidgetResult は execute_dashboard のウィジェットごとの結果
+type dashboardWidgetResult struct {
+	WidgetID          int                     `json:"widget_id"`
+	VisualizationID   int                     `json:"visualization_id"`
+	VisualizationName string                  `json:"visualization_name"`
+	VisualizationType string                  `json:"visualization_type"`
+	QueryID           int                     `json:"query_id"`
+	QueryName         string                  `json:"query_name"`
+	Parameters        map[string]interface{}  `json:"parameters,omitempty"`
+	Source            string                  `json:"source,omitempty"`
+	Columns           []redash.Column         `json:"columns,omitempty"`
+	Rows              json.RawMessage         `json:"rows,omitempty"`
+	Error             string                  `json:"error,omitempty"`
+	ParameterErrors   []redash.ParameterError `json:"parameter_errors,omitempty"`
+}
+
+// dashboardExecutionResult は execute_dashboard の結果
+type dashboardExecutionResult struct {
+	DashboardID      int                     `json:"dashboard_id"`
+	Name             string                  `json:"name"`
+	Parameters       map[string]interface{}  `json:"parameters"`
+	UnusedParameters []string                `json:"unused_parameters,omitempty"` // どのウィジェットにも割り当てられなかったパラメータ
+	Widgets          []dashboardWidgetResult `json:"widgets"`
+}
+
+// executeDashboard はダッシュボードのすべてのウィジェットのクエリを実行
+func (h *Handler) executeDashboard(args map[string]interface{}) mcp.CallToolResult {
+	// dashboard_id の取得
+	dashboardIDFloat, ok := args["dashboard_id"].(float64)
+	if !ok {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: "dashboard_id must be a number",
+				},
+			},
+			IsError: true,
+		}
+	}
+	dashboardID := int(dashboardIDFloat)
+
+	// parameters の取得（オプション）
+	parameters := make(map[string]interface{})
+	if value, exists := args["parameters"]; exists {
+		params, ok := value.(map[string]interface{})
+		if !ok {
+			return mcp.CallToolResult{
+				Content: []mcp.Content{
+					{
+						Type: "text",
+						Text: "parameters must be an object",
+					},
+				},
+				IsError: true,
+			}
+		}
+		parameters = params
+	}
+
+	// max_age の取得（オプション）
+	var maxAge *int
+	if value, exists := args["max_age"]; exists {
+		maxAgeFloat, ok := value.(float64)
+		if !ok || maxAgeFloat < 0 {
+			return mcp.CallToolResult{
+				Content: []mcp.Content{
+					{
+						Type: "text",
+						Text: "max_age must be a non-negative number",
+					},
+				},
+				IsError: true,
+			}
+		}
+		maxAgeInt := int(maxAgeFloat)
+		maxAge = &maxAgeInt
+	}
+
+	// Redash API を呼び出し
+	dashboard, err := h.redashClient.GetDashboard(dashboardID)
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to get dashboard: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	// ウィジェットごとにパラメータを割り当てる（テキストのみのウィジェットは対象外）
+	result := dashboardExecutionResult{
+		DashboardID: dashboard.ID,
+		Name:        dashboard.Name,
+		Parameters:  parameters,
+		Widgets:     []dashboardWidgetResult{},
+	}
+	used := make(map[string]bool)
+	for _, widget := range dashboard.Widgets {
+		if widget.Visualization == nil || widget.Visualization.Query == nil {
+			continue
+		}
+		query := widget.Visualization.Query
+
+		widgetResult := dashboardWidgetResult{
+			WidgetID:          widget.ID,
+			VisualizationID:   widget.Visualization.ID,
+			VisualizationName: widget.Visualization.Name,
+			VisualizationType: widget.Visualization.Type,
+			QueryID:           query.ID,
+			QueryName:         query.Name,
+		}
+
+		definitions, err := query.Parameters()
+		if err != nil {
+			widgetResult.Error = fmt.Sprintf("Failed to parse query parameters: %v", err)
+			result.Widgets = append(result.Widgets, widgetResult)
+			continue
+		}
+
+		values, usedNames := redash.WidgetParameterValues(widget, definitions, parameters)
+		for _, name := range usedNames {
+			used[name] = true
+		}
+
+		validated, err := redash.ValidateQueryParameters(definitions, values)
+		if err != nil {
+			widgetResult.Error = fmt.Sprintf("Invalid parameters: %v", err)
+			var validationErr *redash.ParameterValidationError
+			if errors.As(err, &validationErr) {
+				widgetResult.ParameterErrors = validationErr.Errors
+			}
+			result.Widgets = append(result.Widgets, widgetResult)
+			continue
+		}
+		widgetResult.Parameters = validated
+
+		result.Widgets = append(result.Widgets, widgetResult)
+	}
+
+	for name := range parameters {
+		if !used[name] {
+			result.UnusedParameters = append(result.UnusedParameters, name)
+		}
+	}
+	sort.Strings(result.UnusedParameters)
+
+	// ウィジェットのクエリを同時に実行
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, maxConcurrentWidgetQueries)
+	for i := range result.Widgets {
+		if result.Widgets[i].Error != "" {
+			continue
+		}
+
+		wg.Add(1)
+		go func(widgetResult *dashboardWidgetResult) {
+			defer wg.Done()
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			execution, err := h.redashClient.ExecuteQuery(widgetResult.QueryID, widgetResult.Parameters, maxAge)
+			if err != nil {
+				widgetResult.Error = fmt.Sprintf("Failed to execute query: %v", err)
+				return
+			}
+
+			var data redash.QueryResultData
+			if err := json.Unmarshal(execution.Data, &data); err != nil {
+				widgetResult.Error = fmt.Sprintf("Failed to parse result: %v", err)
+				return
+			}
+			widgetResult.Source = execution.Source
+			widgetResult.Columns = data.Columns
+			widgetResult.Rows = data.Rows
+		}(&result.Widgets[i])
+	}
+	wg.Wait()
+
+	// JSON として整形して返す
+	formatted, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to format dashboard result: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	return mcp.CallToolResult{
+		Content: []mcp.Content{
+			{
+				Type: "text",
+				Text: string(formatted),
+			},
+		},
+		IsError: false,
+	}
+}
@@ -0,0 +1,52 @@
+package tools
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/shshimamo/redash-mcp-go/redash"
+)
+
+func TestUpdateWidgetPreservesOptions(t *testing.T) {
+	dashboard := `{"id": 1, "name": "Sales", "slug": "sales", "widgets": [{"id": 2, "dashboard_id": 1, "text": "", "width": 1,
+		"options": {"isHidden": false, "position": {"col": 0, "row": 0, "sizeX": 3, "sizeY": 8, "autoHeight": false},
+			"parameterMappings": {}, "customLegend": {"show": true}}}]}`
+
+	var body map[string]interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/dashboards/1":
+			io.WriteString(w, dashboard)
+		case "/api/widgets/2":
+			data, _ := io.ReadAll(r.Body)
+			if err := json.Unmarshal(data, &body); err != nil {
+				t.Errorf("invalid request body: %v", err)
+			}
+			io.WriteString(w, `{"id": 2, "dashboard_id": 1, "options": {}}`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	h := &Handler{redashClient: redash.NewClient(srv.URL, "key", true)}
+	result := h.updateWidget(map[string]interface{}{"dashboard_id": float64(1), "widget_id": float64(2), "col": float64(3)})
+	if result.IsError {
+		t.Fatalf("updateWidget() error = %s", result.Content[0].Text)
+	}
+
+	options, _ := body["options"].(map[string]interface{})
+	if _, ok := options["customLegend"]; !ok {
+		t.Errorf("options = %v, want customLegend to be kept", options)
+	}
+	if _, ok := options["parameterMappings"]; !ok {
+		t.Errorf("options = %v, want parameterMappings to be kept", options)
+	}
+	position, _ := options["position"].(map[string]interface{})
+	if position["col"] != float64(3) || position["sizeX"] != float64(3) {
+		t.Errorf("position = %v, want col 3 with the current size", position)
+	}
+}
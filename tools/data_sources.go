@@ -0,0 +1,105 @@
+package tools
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/shshimamo/redash-mcp-go/mcp"
+)
+
+// listDataSources はデータソースの一覧を取得
+func (h *Handler) listDataSources(args map[string]interface{}) mcp.CallToolResult {
+	// Redash API を呼び出し
+	dataSources, err := h.redashClient.ListDataSources()
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to list data sources: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	// JSON として整形して返す
+	formatted, err := json.MarshalIndent(dataSources, "", "  ")
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to format data sources: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	return mcp.CallToolResult{
+		Content: []mcp.Content{
+			{
+				Type: "text",
+				Text: string(formatted),
+			},
+		},
+		IsError: false,
+	}
+}
+
+// getDataSource はデータソースのメタデータを取得
+func (h *Handler) getDataSource(args map[string]interface{}) mcp.CallToolResult {
+	// data_source_id の取得
+	dataSourceIDFloat, ok := args["data_source_id"].(float64)
+	if !ok {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: "data_source_id must be a number",
+				},
+			},
+			IsError: true,
+		}
+	}
+	dataSourceID := int(dataSourceIDFloat)
+
+	// Redash API を呼び出し
+	dataSource, err := h.redashClient.GetDataSource(dataSourceID)
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to get data source: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	// JSON として整形して返す
+	formatted, err := json.MarshalIndent(dataSource, "", "  ")
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to format data source: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	return mcp.CallToolResult{
+		Content: []mcp.Content{
+			{
+				Type: "text",
+				Text: string(formatted),
+			},
+		},
+		IsError: false,
+	}
+}
@@ -0,0 +1,344 @@
+package tools
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/shshimamo/redash-mcp-go/mcp"
+	"github.com/shshimamo/redash-mcp-go/redash"
+)
+
+// listDestinations は通知先の一覧を取得
+func (h *Handler) listDestinations(args map[string]interface{}) mcp.CallToolResult {
+	// include_types の取得（オプション）
+	includeTypes, _ := args["include_types"].(bool)
+
+	// Redash API を呼び出し
+	destinations, err := h.redashClient.ListDestinations()
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to list destinations: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	var result interface{} = destinations
+
+	// include_types が指定された場合は作成可能な種類と設定項目も返す
+	if includeTypes {
+		types, err := h.redashClient.ListDestinationTypes()
+		if err != nil {
+			return mcp.CallToolResult{
+				Content: []mcp.Content{
+					{
+						Type: "text",
+						Text: fmt.Sprintf("Failed to list destination types: %v", err),
+					},
+				},
+				IsError: true,
+			}
+		}
+		result = map[string]interface{}{
+			"destinations": destinations,
+			"types":        types,
+		}
+	}
+
+	// JSON として整形して返す
+	formatted, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to format destinations: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	return mcp.CallToolResult{
+		Content: []mcp.Content{
+			{
+				Type: "text",
+				Text: string(formatted),
+			},
+		},
+		IsError: false,
+	}
+}
+
+// createDestination は新しい通知先を作成
+func (h *Handler) createDestination(args map[string]interface{}) mcp.CallToolResult {
+	// name の取得
+	name, ok := args["name"].(string)
+	if !ok || name == "" {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: "name must be a non-empty string",
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	// type の取得
+	destinationType, ok := args["type"].(string)
+	if !ok || destinationType == "" {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: "type must be a non-empty string",
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	// options の取得
+	options, ok := args["options"].(map[string]interface{})
+	if !ok {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: "options must be an object",
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	log.Printf("Creating destination %q (%s)", name, destinationType)
+
+	// Redash API を呼び出し
+	destination, err := h.redashClient.CreateDestination(redash.CreateDestinationInput{
+		Name:    name,
+		Type:    destinationType,
+		Options: options,
+	})
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to create destination: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	// JSON として整形して返す
+	formatted, err := json.MarshalIndent(destination, "", "  ")
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to format destination: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	return mcp.CallToolResult{
+		Content: []mcp.Content{
+			{
+				Type: "text",
+				Text: string(formatted),
+			},
+		},
+		IsError: false,
+	}
+}
+
+// listAlertSubscriptions はアラートの購読一覧を取得
+func (h *Handler) listAlertSubscriptions(args map[string]interface{}) mcp.CallToolResult {
+	// alert_id の取得
+	alertIDFloat, ok := args["alert_id"].(float64)
+	if !ok {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: "alert_id must be a number",
+				},
+			},
+			IsError: true,
+		}
+	}
+	alertID := int(alertIDFloat)
+
+	// Redash API を呼び出し
+	subscriptions, err := h.redashClient.ListAlertSubscriptions(alertID)
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to list alert subscriptions: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	// JSON として整形して返す
+	formatted, err := json.MarshalIndent(subscriptions, "", "  ")
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to format alert subscriptions: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	return mcp.CallToolResult{
+		Content: []mcp.Content{
+			{
+				Type: "text",
+				Text: string(formatted),
+			},
+		},
+		IsError: false,
+	}
+}
+
+// addAlertSubscription はアラートに購読を追加
+func (h *Handler) addAlertSubscription(args map[string]interface{}) mcp.CallToolResult {
+	// alert_id の取得
+	alertIDFloat, ok := args["alert_id"].(float64)
+	if !ok {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: "alert_id must be a number",
+				},
+			},
+			IsError: true,
+		}
+	}
+	alertID := int(alertIDFloat)
+
+	// destination_id の取得（オプション）
+	var destinationID *int
+	if destinationIDFloat, ok := args["destination_id"].(float64); ok {
+		id := int(destinationIDFloat)
+		destinationID = &id
+	}
+
+	log.Printf("Adding subscription to alert %d", alertID)
+
+	// Redash API を呼び出し
+	subscription, err := h.redashClient.AddAlertSubscription(alertID, destinationID)
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to add alert subscription: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	// JSON として整形して返す
+	formatted, err := json.MarshalIndent(subscription, "", "  ")
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to format alert subscription: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	return mcp.CallToolResult{
+		Content: []mcp.Content{
+			{
+				Type: "text",
+				Text: string(formatted),
+			},
+		},
+		IsError: false,
+	}
+}
+
+// removeAlertSubscription はアラートから購読を削除
+func (h *Handler) removeAlertSubscription(args map[string]interface{}) mcp.CallToolResult {
+	// alert_id の取得
+	alertIDFloat, ok := args["alert_id"].(float64)
+	if !ok {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: "alert_id must be a number",
+				},
+			},
+			IsError: true,
+		}
+	}
+	alertID := int(alertIDFloat)
+
+	// subscription_id の取得
+	subscriptionIDFloat, ok := args["subscription_id"].(float64)
+	if !ok {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: "subscription_id must be a number",
+				},
+			},
+			IsError: true,
+		}
+	}
+	subscriptionID := int(subscriptionIDFloat)
+
+	log.Printf("Removing subscription %d from alert %d", subscriptionID, alertID)
+
+	// Redash API を呼び出し
+	if err := h.redashClient.RemoveAlertSubscription(alertID, subscriptionID); err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to remove alert subscription: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	return mcp.CallToolResult{
+		Content: []mcp.Content{
+			{
+				Type: "text",
+				Text: fmt.Sprintf("Subscription %d removed from alert %d", subscriptionID, alertID),
+			},
+		},
+		IsError: false,
+	}
+}
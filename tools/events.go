@@ -0,0 +1,295 @@
+package tools
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shshimamo/redash-mcp-go/mcp"
+	"github.com/shshimamo/redash-mcp-go/redash"
+)
+
+const (
+	defaultEventLimit = 50
+	maxEventLimit     = 500
+	defaultEventPages = 10
+	maxEventPages     = 50
+	eventPageSize     = 100
+)
+
+// eventSearchResult は list_events の結果
+type eventSearchResult struct {
+	Events        []redash.Event `json:"events"`
+	ScannedEvents int            `json:"scanned_events"`
+	ScannedPages  int            `json:"scanned_pages"`
+	Complete      bool           `json:"complete"` // 条件に一致するイベントをすべて返したか
+	Note          string         `json:"note,omitempty"`
+}
+
+// listEvents は監査ログのイベントを絞り込んで取得
+// /api/events は新しい順のページ送りのみに対応しているため、since より古いイベントに達するか
+// max_pages に達するまでページを読み進めて絞り込む
+func (h *Handler) listEvents(args map[string]interface{}) mcp.CallToolResult {
+	var filter redash.EventFilter
+
+	// user_id の取得（オプション）
+	if value, exists := args["user_id"]; exists {
+		userID, ok := value.(float64)
+		if !ok {
+			return mcp.CallToolResult{
+				Content: []mcp.Content{
+					{
+						Type: "text",
+						Text: "user_id must be a number",
+					},
+				},
+				IsError: true,
+			}
+		}
+		filter.UserIDs = []int{int(userID)}
+	}
+
+	// group_id の取得（オプション）
+	if value, exists := args["group_id"]; exists {
+		groupID, ok := value.(float64)
+		if !ok {
+			return mcp.CallToolResult{
+				Content: []mcp.Content{
+					{
+						Type: "text",
+						Text: "group_id must be a number",
+					},
+				},
+				IsError: true,
+			}
+		}
+		if len(filter.UserIDs) > 0 {
+			return mcp.CallToolResult{
+				Content: []mcp.Content{
+					{
+						Type: "text",
+						Text: "specify either user_id or group_id, not both",
+					},
+				},
+				IsError: true,
+			}
+		}
+
+		members, err := h.redashClient.ListGroupMembers(int(groupID))
+		if err != nil {
+			return mcp.CallToolResult{
+				Content: []mcp.Content{
+					{
+						Type: "text",
+						Text: fmt.Sprintf("Failed to list group members: %v", err),
+					},
+				},
+				IsError: true,
+			}
+		}
+		if len(members) == 0 {
+			return mcp.CallToolResult{
+				Content: []mcp.Content{
+					{
+						Type: "text",
+						Text: fmt.Sprintf("Group %d has no members", int(groupID)),
+					},
+				},
+				IsError: true,
+			}
+		}
+		for _, member := range members {
+			filter.UserIDs = append(filter.UserIDs, member.ID)
+		}
+	}
+
+	// action, object_type の取得（オプション）
+	filter.Action, _ = args["action"].(string)
+	filter.ObjectType, _ = args["object_type"].(string)
+
+	// object_id の取得（オプション）
+	switch value := args["object_id"].(type) {
+	case nil:
+	case float64:
+		filter.ObjectID = strconv.Itoa(int(value))
+	case string:
+		filter.ObjectID = value
+	default:
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: "object_id must be a number or string",
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	// since, until の取得（オプション）
+	for _, key := range []string{"since", "until"} {
+		value, exists := args[key]
+		if !exists {
+			continue
+		}
+		text, _ := value.(string)
+		t, err := parseEventTime(text, key == "until")
+		if err != nil {
+			return mcp.CallToolResult{
+				Content: []mcp.Content{
+					{
+						Type: "text",
+						Text: fmt.Sprintf("%s: %v", key, err),
+					},
+				},
+				IsError: true,
+			}
+		}
+		if key == "since" {
+			filter.Since = t
+		} else {
+			filter.Until = t
+		}
+	}
+
+	// limit の取得（オプション）
+	limit := defaultEventLimit
+	if value, exists := args["limit"]; exists {
+		limitFloat, ok := value.(float64)
+		if !ok || limitFloat < 1 || limitFloat > maxEventLimit {
+			return mcp.CallToolResult{
+				Content: []mcp.Content{
+					{
+						Type: "text",
+						Text: fmt.Sprintf("limit must be a number between 1 and %d", maxEventLimit),
+					},
+				},
+				IsError: true,
+			}
+		}
+		limit = int(limitFloat)
+	}
+
+	// max_pages の取得（オプション）
+	maxPages := defaultEventPages
+	if value, exists := args["max_pages"]; exists {
+		maxPagesFloat, ok := value.(float64)
+		if !ok || maxPagesFloat < 1 || maxPagesFloat > maxEventPages {
+			return mcp.CallToolResult{
+				Content: []mcp.Content{
+					{
+						Type: "text",
+						Text: fmt.Sprintf("max_pages must be a number between 1 and %d", maxEventPages),
+					},
+				},
+				IsError: true,
+			}
+		}
+		maxPages = int(maxPagesFloat)
+	}
+
+	// Redash API を呼び出し（新しい順にページを読み進める）
+	result := eventSearchResult{Events: []redash.Event{}}
+	limited := false
+pages:
+	for page := 1; page <= maxPages; page++ {
+		list, err := h.redashClient.ListEvents(page, eventPageSize)
+		if err != nil {
+			return mcp.CallToolResult{
+				Content: []mcp.Content{
+					{
+						Type: "text",
+						Text: fmt.Sprintf("Failed to list events: %v", err),
+					},
+				},
+				IsError: true,
+			}
+		}
+		result.ScannedPages++
+
+		for _, event := range list.Results {
+			// since より古いイベントに達したら以降のページは読まない
+			if !filter.Since.IsZero() {
+				if t, err := event.Time(); err == nil && t.Before(filter.Since) {
+					result.Complete = true
+					break pages
+				}
+			}
+
+			result.ScannedEvents++
+			if !filter.Match(event) {
+				continue
+			}
+			if len(result.Events) == limit {
+				limited = true
+				break pages
+			}
+			result.Events = append(result.Events, event)
+		}
+
+		if len(list.Results) == 0 || page*eventPageSize >= list.Count {
+			result.Complete = true
+			break
+		}
+	}
+
+	switch {
+	case limited:
+		result.Note = fmt.Sprintf("More than %d events matched; narrow the filters or increase limit", limit)
+	case !result.Complete:
+		result.Note = fmt.Sprintf("Stopped after scanning %d pages (%d events); older events were not searched. Set since or increase max_pages", result.ScannedPages, result.ScannedEvents)
+	}
+
+	// JSON として整形して返す
+	formatted, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to format events: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	return mcp.CallToolResult{
+		Content: []mcp.Content{
+			{
+				Type: "text",
+				Text: string(formatted),
+			},
+		},
+		IsError: false,
+	}
+}
+
+// parseEventTime は since / until の値を時刻に変換
+// "24h" や "7d" のような現在からの相対時間、RFC 3339 の日時、YYYY-MM-DD（UTC）の日付を受け付ける
+// endOfDay が true の場合、日付はその日の終わり（翌日の 0 時）とみなす
+func parseEventTime(text string, endOfDay bool) (time.Time, error) {
+	text = strings.TrimSpace(text)
+
+	if days, ok := strings.CutSuffix(text, "d"); ok {
+		if n, err := strconv.Atoi(days); err == nil && n >= 0 {
+			return time.Now().AddDate(0, 0, -n), nil
+		}
+	}
+	if d, err := time.ParseDuration(text); err == nil && d >= 0 {
+		return time.Now().Add(-d), nil
+	}
+	if t, err := time.Parse(time.RFC3339, text); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse("2006-01-02", text); err == nil {
+		if endOfDay {
+			t = t.AddDate(0, 0, 1)
+		}
+		return t, nil
+	}
+
+	return time.Time{}, fmt.Errorf("invalid time %q (use a relative time like \"24h\" or \"7d\", RFC 3339, or YYYY-MM-DD)", text)
+}
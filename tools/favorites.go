@@ -0,0 +1,240 @@
+package tools
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/shshimamo/redash-mcp-go/mcp"
+	"github.com/shshimamo/redash-mcp-go/redash"
+)
+
+// favoriteResult はお気に入りの追加・削除の結果
+type favoriteResult struct {
+	ObjectType string `json:"object_type"`
+	ID         int    `json:"id"`
+	Name       string `json:"name"`
+	IsFavorite bool   `json:"is_favorite"`
+}
+
+// favoritesResult は list_favorites の結果
+type favoritesResult struct {
+	Queries    *redash.QueryList     `json:"queries,omitempty"`
+	Dashboards *redash.DashboardList `json:"dashboards,omitempty"`
+}
+
+// setFavorite はクエリ・ダッシュボードをお気に入りに追加・削除
+func (h *Handler) setFavorite(args map[string]interface{}, favorite bool) mcp.CallToolResult {
+	// object_type の取得
+	objectType, errMsg := parseObjectType(args, true)
+	if errMsg != "" {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: errMsg,
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	// object_id の取得
+	objectIDFloat, ok := args["object_id"].(float64)
+	if !ok {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: "object_id must be a number",
+				},
+			},
+			IsError: true,
+		}
+	}
+	objectID := int(objectIDFloat)
+
+	result := favoriteResult{
+		ObjectType: objectType,
+		ID:         objectID,
+		IsFavorite: favorite,
+	}
+
+	// Redash API を呼び出し
+	switch objectType {
+	case objectTypeQuery:
+		query, err := h.redashClient.GetQuery(objectID)
+		if err != nil {
+			return mcp.CallToolResult{
+				Content: []mcp.Content{
+					{
+						Type: "text",
+						Text: fmt.Sprintf("Failed to get query: %v", err),
+					},
+				},
+				IsError: true,
+			}
+		}
+		result.Name = query.Name
+
+		if err := h.redashClient.SetQueryFavorite(objectID, favorite); err != nil {
+			return mcp.CallToolResult{
+				Content: []mcp.Content{
+					{
+						Type: "text",
+						Text: fmt.Sprintf("Failed to update favorite: %v", err),
+					},
+				},
+				IsError: true,
+			}
+		}
+	case objectTypeDashboard:
+		// お気に入り API は slug で指定するため、先にダッシュボードを取得
+		dashboard, err := h.redashClient.GetDashboard(objectID)
+		if err != nil {
+			return mcp.CallToolResult{
+				Content: []mcp.Content{
+					{
+						Type: "text",
+						Text: fmt.Sprintf("Failed to get dashboard: %v", err),
+					},
+				},
+				IsError: true,
+			}
+		}
+		result.Name = dashboard.Name
+
+		if err := h.redashClient.SetDashboardFavorite(dashboard.Slug, favorite); err != nil {
+			return mcp.CallToolResult{
+				Content: []mcp.Content{
+					{
+						Type: "text",
+						Text: fmt.Sprintf("Failed to update favorite: %v", err),
+					},
+				},
+				IsError: true,
+			}
+		}
+	}
+
+	// JSON として整形して返す
+	formatted, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to format result: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	return mcp.CallToolResult{
+		Content: []mcp.Content{
+			{
+				Type: "text",
+				Text: string(formatted),
+			},
+		},
+		IsError: false,
+	}
+}
+
+// listFavorites はお気に入りのクエリ・ダッシュボードの一覧を取得
+func (h *Handler) listFavorites(args map[string]interface{}) mcp.CallToolResult {
+	// object_type の取得（オプション、未指定の場合は両方）
+	objectType, errMsg := parseObjectType(args, false)
+	if errMsg != "" {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: errMsg,
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	// page, page_size の取得（オプション）
+	opts, errMsg := parseQueryListOptions(args)
+	if errMsg != "" {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: errMsg,
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	var result favoritesResult
+
+	// Redash API を呼び出し
+	if objectType == "" || objectType == objectTypeQuery {
+		queries, err := h.redashClient.ListQueries(redash.QueryListOptions{
+			Page:          opts.Page,
+			PageSize:      opts.PageSize,
+			FavoritesOnly: true,
+		})
+		if err != nil {
+			return mcp.CallToolResult{
+				Content: []mcp.Content{
+					{
+						Type: "text",
+						Text: fmt.Sprintf("Failed to list favorite queries: %v", err),
+					},
+				},
+				IsError: true,
+			}
+		}
+		result.Queries = queries
+	}
+
+	if objectType == "" || objectType == objectTypeDashboard {
+		dashboards, err := h.redashClient.ListDashboards(redash.DashboardListOptions{
+			Page:          opts.Page,
+			PageSize:      opts.PageSize,
+			FavoritesOnly: true,
+		})
+		if err != nil {
+			return mcp.CallToolResult{
+				Content: []mcp.Content{
+					{
+						Type: "text",
+						Text: fmt.Sprintf("Failed to list favorite dashboards: %v", err),
+					},
+				},
+				IsError: true,
+			}
+		}
+		result.Dashboards = dashboards
+	}
+
+	// JSON として整形して返す
+	formatted, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to format favorites: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	return mcp.CallToolResult{
+		Content: []mcp.Content{
+			{
+				Type: "text",
+				Text: string(formatted),
+			},
+		},
+		IsError: false,
+	}
+}
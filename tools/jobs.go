@@ -0,0 +1,266 @@
+package tools
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/shshimamo/redash-mcp-go/mcp"
+	"github.com/shshimamo/redash-mcp-go/redash"
+)
+
+// wait_for_job のタイムアウト（秒）
+const (
+	defaultJobWaitSeconds = 30
+	maxJobWaitSeconds     = 600
+)
+
+// jobStatus はクエリ実行ジョブの状態
+type jobStatus struct {
+	JobID         string `json:"job_id"`
+	Status        string `json:"status"`
+	Error         string `json:"error,omitempty"`
+	QueryResultID int    `json:"query_result_id,omitempty"`
+}
+
+// newJobStatus は Redash のジョブを jobStatus に変換
+func newJobStatus(job *redash.QueryJob) jobStatus {
+	return jobStatus{
+		JobID:         job.ID,
+		Status:        redash.JobStatusName(job.Status),
+		Error:         string(job.Error),
+		QueryResultID: job.QueryResultID,
+	}
+}
+
+// startedQueryResult は非同期実行の開始結果を返す
+// キャッシュがある場合は結果を、新規実行の場合はジョブの状態を返す
+// withSource が true の場合、同期実行の execute_query と同じく結果に source を付ける
+func (h *Handler) startedQueryResult(started *redash.QueryExecuteResponse, withSource bool) mcp.CallToolResult {
+	// パターン1: キャッシュがある場合は直接結果を返す
+	if started.QueryResult != nil {
+		var formatted string
+		var err error
+		if withSource {
+			formatted, err = h.formatQueryExecution(redash.QueryResultSourceCache, "", started.QueryResult.Data)
+		} else {
+			formatted, err = h.formatQueryResult(started.QueryResult.Data)
+		}
+		if err != nil {
+			return mcp.CallToolResult{
+				Content: []mcp.Content{
+					{
+						Type: "text",
+						Text: fmt.Sprintf("Failed to format result: %v", err),
+					},
+				},
+				IsError: true,
+			}
+		}
+
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: formatted,
+				},
+			},
+			IsError: false,
+		}
+	}
+
+	// パターン2: ジョブの状態を返す
+	formatted, err := json.MarshalIndent(newJobStatus(started.Job), "", "  ")
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to format job: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	return mcp.CallToolResult{
+		Content: []mcp.Content{
+			{
+				Type: "text",
+				Text: string(formatted),
+			},
+		},
+		IsError: false,
+	}
+}
+
+// getJobStatus はクエリ実行ジョブの状態を取得
+func (h *Handler) getJobStatus(args map[string]interface{}) mcp.CallToolResult {
+	// job_id の取得
+	jobID, ok := args["job_id"].(string)
+	if !ok || jobID == "" {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: "job_id must be a non-empty string",
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	// Redash API を呼び出し
+	job, err := h.redashClient.GetJob(jobID)
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to get job status: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	// JSON として整形して返す
+	formatted, err := json.MarshalIndent(newJobStatus(job), "", "  ")
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to format job: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	return mcp.CallToolResult{
+		Content: []mcp.Content{
+			{
+				Type: "text",
+				Text: string(formatted),
+			},
+		},
+		IsError: false,
+	}
+}
+
+// waitForJob はクエリ実行ジョブの完了を待って結果を返す
+func (h *Handler) waitForJob(args map[string]interface{}) mcp.CallToolResult {
+	// job_id の取得
+	jobID, ok := args["job_id"].(string)
+	if !ok || jobID == "" {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: "job_id must be a non-empty string",
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	// timeout_seconds の取得（オプション）
+	timeoutSeconds := defaultJobWaitSeconds
+	if value, exists := args["timeout_seconds"]; exists {
+		timeoutFloat, ok := value.(float64)
+		if !ok || timeoutFloat < 1 || timeoutFloat > maxJobWaitSeconds {
+			return mcp.CallToolResult{
+				Content: []mcp.Content{
+					{
+						Type: "text",
+						Text: fmt.Sprintf("timeout_seconds must be a number between 1 and %d", maxJobWaitSeconds),
+					},
+				},
+				IsError: true,
+			}
+		}
+		timeoutSeconds = int(timeoutFloat)
+	}
+
+	// Redash API を呼び出し
+	result, err := h.redashClient.WaitForJob(jobID, time.Duration(timeoutSeconds)*time.Second)
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to wait for job: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	// 結果を整形
+	formatted, err := h.formatQueryResult(result)
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to format result: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	return mcp.CallToolResult{
+		Content: []mcp.Content{
+			{
+				Type: "text",
+				Text: formatted,
+			},
+		},
+		IsError: false,
+	}
+}
+
+// cancelJob はクエリ実行ジョブをキャンセル
+func (h *Handler) cancelJob(args map[string]interface{}) mcp.CallToolResult {
+	// job_id の取得
+	jobID, ok := args["job_id"].(string)
+	if !ok || jobID == "" {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: "job_id must be a non-empty string",
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	log.Printf("Cancelling job %s", jobID)
+
+	// Redash API を呼び出し
+	if err := h.redashClient.CancelJob(jobID); err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to cancel job: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	return mcp.CallToolResult{
+		Content: []mcp.Content{
+			{
+				Type: "text",
+				Text: fmt.Sprintf("Job %s cancelled", jobID),
+			},
+		},
+		IsError: false,
+	}
+}
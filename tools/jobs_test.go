@@ -0,0 +1,46 @@
+package tools
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/shshimamo/redash-mcp-go/redash"
+)
+
+func TestExecuteQueryAsyncCacheHitSource(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/queries/1":
+			io.WriteString(w, `{"id": 1, "name": "Users", "query": "SELECT 1", "data_source_id": 1, "options": {}}`)
+		case "/api/queries/1/results":
+			io.WriteString(w, `{"query_result": {"id": 10, "data": {"columns": [{"name": "n", "type": "integer"}], "rows": [{"n": 1}]}}}`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	h := &Handler{redashClient: redash.NewClient(srv.URL, "key", true)}
+	result := h.executeQuery(map[string]interface{}{"query_id": float64(1), "async": true})
+	if result.IsError {
+		t.Fatalf("executeQuery() error = %s", result.Content[0].Text)
+	}
+
+	var got queryExecutionResult
+	if err := json.Unmarshal([]byte(result.Content[0].Text), &got); err != nil {
+		t.Fatalf("invalid result: %v", err)
+	}
+	if got.Source != redash.QueryResultSourceCache {
+		t.Errorf("source = %q, want %q", got.Source, redash.QueryResultSourceCache)
+	}
+	var rows []map[string]interface{}
+	if err := json.Unmarshal(got.Rows, &rows); err != nil {
+		t.Fatalf("invalid rows: %v", err)
+	}
+	if len(got.Columns) != 1 || len(rows) != 1 || rows[0]["n"] != float64(1) {
+		t.Errorf("result = %+v, want the cached columns and rows", got)
+	}
+}
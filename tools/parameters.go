@@ -0,0 +1,291 @@
+package tools
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/shshimamo/redash-mcp-go/mcp"
+	"github.com/shshimamo/redash-mcp-go/redash"
+)
+
+// parseAdhocParameters は execute_adhoc_query の parameters 引数を型付きのパラメータに変換
+// {"name": {"type": "text", "value": "...", "options": [...]}} の形式で受け取る
+// 不正な引数がある場合はエラーメッセージを返す
+func parseAdhocParameters(value interface{}) (map[string]redash.AdhocParameter, string) {
+	params, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, "parameters must be an object"
+	}
+
+	result := make(map[string]redash.AdhocParameter, len(params))
+	for name, raw := range params {
+		definition, ok := raw.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Sprintf(`parameter %q must be an object like {"type": "text", "value": "..."}`, name)
+		}
+
+		paramType, ok := definition["type"].(string)
+		if !ok || paramType == "" {
+			return nil, fmt.Sprintf("parameter %q must have a type", name)
+		}
+
+		paramValue, exists := definition["value"]
+		if !exists || paramValue == nil {
+			return nil, fmt.Sprintf("parameter %q must have a value", name)
+		}
+
+		param := redash.AdhocParameter{
+			Type:  paramType,
+			Value: paramValue,
+		}
+		if optionsValue, exists := definition["options"]; exists {
+			options, ok := toStringSlice(optionsValue)
+			if !ok {
+				return nil, fmt.Sprintf("options of parameter %q must be an array of strings", name)
+			}
+			param.Options = options
+		}
+
+		result[name] = param
+	}
+
+	return result, ""
+}
+
+// parameterOptionsResult は get_parameter_options の結果
+type parameterOptionsResult struct {
+	QueryID       int                      `json:"query_id"`
+	ParameterName string                   `json:"parameter_name"`
+	Type          string                   `json:"type"`
+	MultiValue    bool                     `json:"multi_value"`
+	Options       []redash.ParameterOption `json:"options"`
+}
+
+// getParameterOptions は enum / query 型パラメータの選択肢を取得
+func (h *Handler) getParameterOptions(args map[string]interface{}) mcp.CallToolResult {
+	// query_id の取得
+	queryIDFloat, ok := args["query_id"].(float64)
+	if !ok {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: "query_id must be a number",
+				},
+			},
+			IsError: true,
+		}
+	}
+	queryID := int(queryIDFloat)
+
+	// parameter_name の取得
+	parameterName, ok := args["parameter_name"].(string)
+	if !ok || parameterName == "" {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: "parameter_name must be a non-empty string",
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	// Redash API を呼び出し
+	query, err := h.redashClient.GetQuery(queryID)
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to get query: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	parameters, err := query.Parameters()
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to parse query parameters: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	// パラメータを名前で検索
+	var parameter *redash.QueryParameter
+	names := make([]string, 0, len(parameters))
+	for i := range parameters {
+		names = append(names, parameters[i].Name)
+		if parameters[i].Name == parameterName {
+			parameter = &parameters[i]
+		}
+	}
+	if parameter == nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Query %d has no parameter %q (parameters: %s)", queryID, parameterName, strings.Join(names, ", ")),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	result := parameterOptionsResult{
+		QueryID:       queryID,
+		ParameterName: parameter.Name,
+		Type:          parameter.Type,
+		MultiValue:    parameter.MultiValuesOptions != nil,
+	}
+
+	switch parameter.Type {
+	case redash.ParameterTypeEnum:
+		// enum の選択肢は改行区切りで保存されている
+		result.Options = []redash.ParameterOption{}
+		for _, value := range parameter.EnumValues() {
+			result.Options = append(result.Options, redash.ParameterOption{Name: value, Value: value})
+		}
+	case redash.ParameterTypeQuery:
+		if parameter.QueryID == nil {
+			return mcp.CallToolResult{
+				Content: []mcp.Content{
+					{
+						Type: "text",
+						Text: fmt.Sprintf("Parameter %q has no dropdown query configured", parameter.Name),
+					},
+				},
+				IsError: true,
+			}
+		}
+
+		// 選択肢を返すクエリの結果を取得
+		options, err := h.redashClient.GetQueryDropdownOptions(queryID, *parameter.QueryID)
+		if err != nil {
+			return mcp.CallToolResult{
+				Content: []mcp.Content{
+					{
+						Type: "text",
+						Text: fmt.Sprintf("Failed to get dropdown options: %v", err),
+					},
+				},
+				IsError: true,
+			}
+		}
+		result.Options = options
+	default:
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Parameter %q is of type %s and has no predefined options", parameter.Name, parameter.Type),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	// JSON として整形して返す
+	formatted, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to format parameter options: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	return mcp.CallToolResult{
+		Content: []mcp.Content{
+			{
+				Type: "text",
+				Text: string(formatted),
+			},
+		},
+		IsError: false,
+	}
+}
+
+// validateQueryParameters は保存済みクエリのパラメータ定義に沿って値を検証
+// 指定のないパラメータはデフォルト値で補った値を返し、検証に失敗した場合はエラーの結果を返す
+func (h *Handler) validateQueryParameters(queryID int, values map[string]interface{}) (map[string]interface{}, *mcp.CallToolResult) {
+	// Redash API を呼び出し
+	query, err := h.redashClient.GetQuery(queryID)
+	if err != nil {
+		return nil, &mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to get query: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	definitions, err := query.Parameters()
+	if err != nil {
+		return nil, &mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to parse query parameters: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	validated, err := redash.ValidateQueryParameters(definitions, values)
+	if err != nil {
+		// 検証エラーは有効な値の一覧とともに JSON で返す
+		var validationErr *redash.ParameterValidationError
+		if !errors.As(err, &validationErr) {
+			return nil, &mcp.CallToolResult{
+				Content: []mcp.Content{
+					{
+						Type: "text",
+						Text: fmt.Sprintf("Invalid parameters: %v", err),
+					},
+				},
+				IsError: true,
+			}
+		}
+
+		formatted, _ := json.MarshalIndent(parameterValidationResult{
+			Error:  fmt.Sprintf("Invalid parameters for query %d", queryID),
+			Errors: validationErr.Errors,
+		}, "", "  ")
+		return nil, &mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: string(formatted),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	return validated, nil
+}
+
+// parameterValidationResult はパラメータの検証エラーの結果
+type parameterValidationResult struct {
+	Error  string                  `json:"error"`
+	Errors []redash.ParameterError `json:"errors"`
+}
@@ -0,0 +1,232 @@
+package tools
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/shshimamo/redash-mcp-go/mcp"
+	"github.com/shshimamo/redash-mcp-go/redash"
+)
+
+// permissionsResult は get_permissions の結果
+type permissionsResult struct {
+	ObjectType string        `json:"object_type"`
+	ID         int           `json:"id"`
+	Name       string        `json:"name"`
+	Owner      *redash.User  `json:"owner,omitempty"`
+	CanModify  []redash.User `json:"can_modify"` // 作成者と管理者以外で編集できるユーザー
+}
+
+// aclObjectTypes は object_type 引数を ACL API のパスに対応付ける
+var aclObjectTypes = map[string]string{
+	objectTypeQuery:     redash.ACLObjectQueries,
+	objectTypeDashboard: redash.ACLObjectDashboards,
+}
+
+// getPermissions はクエリ・ダッシュボードを編集できるユーザーを取得
+func (h *Handler) getPermissions(args map[string]interface{}) mcp.CallToolResult {
+	// object_type の取得
+	objectType, errMsg := parseObjectType(args, true)
+	if errMsg != "" {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: errMsg,
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	// object_id の取得
+	objectIDFloat, ok := args["object_id"].(float64)
+	if !ok {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: "object_id must be a number",
+				},
+			},
+			IsError: true,
+		}
+	}
+	objectID := int(objectIDFloat)
+
+	result := permissionsResult{
+		ObjectType: objectType,
+		ID:         objectID,
+	}
+
+	// 作成者（所有者）を取得
+	switch objectType {
+	case objectTypeQuery:
+		query, err := h.redashClient.GetQuery(objectID)
+		if err != nil {
+			return mcp.CallToolResult{
+				Content: []mcp.Content{
+					{
+						Type: "text",
+						Text: fmt.Sprintf("Failed to get query: %v", err),
+					},
+				},
+				IsError: true,
+			}
+		}
+		result.Name, result.Owner = query.Name, query.User
+	case objectTypeDashboard:
+		dashboard, err := h.redashClient.GetDashboard(objectID)
+		if err != nil {
+			return mcp.CallToolResult{
+				Content: []mcp.Content{
+					{
+						Type: "text",
+						Text: fmt.Sprintf("Failed to get dashboard: %v", err),
+					},
+				},
+				IsError: true,
+			}
+		}
+		result.Name, result.Owner = dashboard.Name, dashboard.User
+	}
+
+	// Redash API を呼び出し
+	acl, err := h.redashClient.GetACL(aclObjectTypes[objectType], objectID)
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to get permissions: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+	result.CanModify = acl.Modify
+	if result.CanModify == nil {
+		result.CanModify = []redash.User{}
+	}
+
+	// JSON として整形して返す
+	formatted, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to format permissions: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	return mcp.CallToolResult{
+		Content: []mcp.Content{
+			{
+				Type: "text",
+				Text: string(formatted),
+			},
+		},
+		IsError: false,
+	}
+}
+
+// setModifyAccess はユーザーにクエリ・ダッシュボードの編集権限を付与・取り消す
+func (h *Handler) setModifyAccess(args map[string]interface{}, grant bool) mcp.CallToolResult {
+	// object_type の取得
+	objectType, errMsg := parseObjectType(args, true)
+	if errMsg != "" {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: errMsg,
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	// object_id の取得
+	objectIDFloat, ok := args["object_id"].(float64)
+	if !ok {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: "object_id must be a number",
+				},
+			},
+			IsError: true,
+		}
+	}
+	objectID := int(objectIDFloat)
+
+	// user_id の取得
+	userIDFloat, ok := args["user_id"].(float64)
+	if !ok {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: "user_id must be a number",
+				},
+			},
+			IsError: true,
+		}
+	}
+	userID := int(userIDFloat)
+
+	// Redash API を呼び出し
+	if grant {
+		log.Printf("Granting modify access on %s %d to user %d", objectType, objectID, userID)
+		if err := h.redashClient.GrantAccess(aclObjectTypes[objectType], objectID, redash.AccessTypeModify, userID); err != nil {
+			return mcp.CallToolResult{
+				Content: []mcp.Content{
+					{
+						Type: "text",
+						Text: fmt.Sprintf("Failed to grant access: %v", err),
+					},
+				},
+				IsError: true,
+			}
+		}
+
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("User %d can now modify %s %d", userID, objectType, objectID),
+				},
+			},
+			IsError: false,
+		}
+	}
+
+	log.Printf("Revoking modify access on %s %d from user %d", objectType, objectID, userID)
+	if err := h.redashClient.RevokeAccess(aclObjectTypes[objectType], objectID, redash.AccessTypeModify, userID); err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to revoke access: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	return mcp.CallToolResult{
+		Content: []mcp.Content{
+			{
+				Type: "text",
+				Text: fmt.Sprintf("User %d can no longer modify %s %d (owners and admins keep access)", userID, objectType, objectID),
+			},
+		},
+		IsError: false,
+	}
+}
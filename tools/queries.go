@@ -0,0 +1,822 @@
+package tools
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/shshimamo/redash-mcp-go/mcp"
+	"github.com/shshimamo/redash-mcp-go/redash"
+)
+
+// listQueries は保存済みクエリの一覧を取得
+func (h *Handler) listQueries(args map[string]interface{}) mcp.CallToolResult {
+	opts, errMsg := parseQueryListOptions(args)
+	if errMsg != "" {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: errMsg,
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	// Redash API を呼び出し
+	list, err := h.redashClient.ListQueries(opts)
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to list queries: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	// JSON として整形して返す
+	formatted, err := json.MarshalIndent(list, "", "  ")
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to format queries: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	return mcp.CallToolResult{
+		Content: []mcp.Content{
+			{
+				Type: "text",
+				Text: string(formatted),
+			},
+		},
+		IsError: false,
+	}
+}
+
+// searchQueries はクエリをテキスト検索
+func (h *Handler) searchQueries(args map[string]interface{}) mcp.CallToolResult {
+	opts, errMsg := parseQueryListOptions(args)
+	if errMsg == "" && opts.Search == "" {
+		errMsg = "q must be a non-empty string"
+	}
+	if errMsg != "" {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: errMsg,
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	// Redash API を呼び出し
+	list, err := h.redashClient.SearchQueries(opts)
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to search queries: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	// JSON として整形して返す
+	formatted, err := json.MarshalIndent(list, "", "  ")
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to format queries: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	return mcp.CallToolResult{
+		Content: []mcp.Content{
+			{
+				Type: "text",
+				Text: string(formatted),
+			},
+		},
+		IsError: false,
+	}
+}
+
+// parseQueryListOptions は list_queries / search_queries の引数を解釈
+// 不正な引数がある場合はエラーメッセージを返す
+func parseQueryListOptions(args map[string]interface{}) (redash.QueryListOptions, string) {
+	var opts redash.QueryListOptions
+
+	// page の取得（オプション）
+	if value, exists := args["page"]; exists {
+		page, ok := value.(float64)
+		if !ok || page < 1 {
+			return opts, "page must be a positive number"
+		}
+		opts.Page = int(page)
+	}
+
+	// page_size の取得（オプション）
+	if value, exists := args["page_size"]; exists {
+		pageSize, ok := value.(float64)
+		if !ok || pageSize < 1 {
+			return opts, "page_size must be a positive number"
+		}
+		opts.PageSize = int(pageSize)
+	}
+
+	// q の取得（オプション）
+	if value, exists := args["q"]; exists {
+		q, ok := value.(string)
+		if !ok {
+			return opts, "q must be a string"
+		}
+		opts.Search = q
+	}
+
+	// tags の取得（オプション）
+	if value, exists := args["tags"]; exists {
+		tags, ok := toStringSlice(value)
+		if !ok {
+			return opts, "tags must be an array of strings"
+		}
+		opts.Tags = tags
+	}
+
+	// favorites_only の取得（オプション）
+	opts.FavoritesOnly, _ = args["favorites_only"].(bool)
+
+	return opts, ""
+}
+
+// createQuery は新しいクエリを作成
+func (h *Handler) createQuery(args map[string]interface{}) mcp.CallToolResult {
+	// name の取得
+	name, ok := args["name"].(string)
+	if !ok || name == "" {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: "name must be a non-empty string",
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	// query の取得
+	query, ok := args["query"].(string)
+	if !ok {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: "query must be a string",
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	// data_source_id の取得
+	dataSourceIDFloat, ok := args["data_source_id"].(float64)
+	if !ok {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: "data_source_id must be a number",
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	input := redash.CreateQueryInput{
+		Name:         name,
+		Query:        query,
+		DataSourceID: int(dataSourceIDFloat),
+	}
+
+	// description の取得（オプション）
+	if description, ok := args["description"].(string); ok {
+		input.Description = description
+	}
+
+	// tags の取得（オプション）
+	if value, exists := args["tags"]; exists {
+		tags, ok := toStringSlice(value)
+		if !ok {
+			return mcp.CallToolResult{
+				Content: []mcp.Content{
+					{
+						Type: "text",
+						Text: "tags must be an array of strings",
+					},
+				},
+				IsError: true,
+			}
+		}
+		input.Tags = tags
+	}
+
+	// parameters の取得（オプション）
+	if value, exists := args["parameters"]; exists {
+		parameters, ok := value.([]interface{})
+		if !ok {
+			return mcp.CallToolResult{
+				Content: []mcp.Content{
+					{
+						Type: "text",
+						Text: "parameters must be an array of parameter definitions",
+					},
+				},
+				IsError: true,
+			}
+		}
+		input.Options = map[string]interface{}{
+			"parameters": parameters,
+		}
+	}
+
+	// Redash API を呼び出し
+	created, err := h.redashClient.CreateQuery(input)
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to create query: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	// publish が指定された場合は下書きを解除
+	if publish, _ := args["publish"].(bool); publish {
+		isDraft := false
+		created, err = h.redashClient.UpdateQuery(created.ID, redash.UpdateQueryInput{IsDraft: &isDraft})
+		if err != nil {
+			return mcp.CallToolResult{
+				Content: []mcp.Content{
+					{
+						Type: "text",
+						Text: fmt.Sprintf("Query was created but failed to publish: %v", err),
+					},
+				},
+				IsError: true,
+			}
+		}
+	}
+
+	// JSON として整形して返す
+	formatted, err := json.MarshalIndent(created, "", "  ")
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to format query: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	return mcp.CallToolResult{
+		Content: []mcp.Content{
+			{
+				Type: "text",
+				Text: string(formatted),
+			},
+		},
+		IsError: false,
+	}
+}
+
+// updateQuery は既存のクエリを更新
+func (h *Handler) updateQuery(args map[string]interface{}) mcp.CallToolResult {
+	// query_id の取得
+	queryIDFloat, ok := args["query_id"].(float64)
+	if !ok {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: "query_id must be a number",
+				},
+			},
+			IsError: true,
+		}
+	}
+	queryID := int(queryIDFloat)
+
+	var input redash.UpdateQueryInput
+	changed := false
+
+	// name の取得（オプション）
+	if name, ok := args["name"].(string); ok {
+		input.Name = &name
+		changed = true
+	}
+
+	// query の取得（オプション）
+	if query, ok := args["query"].(string); ok {
+		input.Query = &query
+		changed = true
+	}
+
+	// description の取得（オプション）
+	if description, ok := args["description"].(string); ok {
+		input.Description = &description
+		changed = true
+	}
+
+	// tags の取得（オプション）
+	if value, exists := args["tags"]; exists {
+		tags, ok := toStringSlice(value)
+		if !ok {
+			return mcp.CallToolResult{
+				Content: []mcp.Content{
+					{
+						Type: "text",
+						Text: "tags must be an array of strings",
+					},
+				},
+				IsError: true,
+			}
+		}
+		input.Tags = &tags
+		changed = true
+	}
+
+	// is_draft の取得（オプション）
+	if isDraft, ok := args["is_draft"].(bool); ok {
+		input.IsDraft = &isDraft
+		changed = true
+	}
+
+	// parameters の取得（オプション）
+	// Redash は options を丸ごと置き換えるため、既存の options に parameters を上書きして送る
+	if value, exists := args["parameters"]; exists {
+		parameters, ok := value.([]interface{})
+		if !ok {
+			return mcp.CallToolResult{
+				Content: []mcp.Content{
+					{
+						Type: "text",
+						Text: "parameters must be an array of parameter definitions",
+					},
+				},
+				IsError: true,
+			}
+		}
+
+		current, err := h.redashClient.GetQuery(queryID)
+		if err != nil {
+			return mcp.CallToolResult{
+				Content: []mcp.Content{
+					{
+						Type: "text",
+						Text: fmt.Sprintf("Failed to get query: %v", err),
+					},
+				},
+				IsError: true,
+			}
+		}
+
+		options := make(map[string]interface{})
+		for key, value := range current.Options {
+			options[key] = value
+		}
+		options["parameters"] = parameters
+		input.Options = options
+		changed = true
+	}
+
+	if !changed {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: "at least one of name, query, description, tags, parameters or is_draft must be specified",
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	// Redash API を呼び出し
+	updated, err := h.redashClient.UpdateQuery(queryID, input)
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to update query: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	// JSON として整形して返す
+	formatted, err := json.MarshalIndent(updated, "", "  ")
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to format query: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	return mcp.CallToolResult{
+		Content: []mcp.Content{
+			{
+				Type: "text",
+				Text: string(formatted),
+			},
+		},
+		IsError: false,
+	}
+}
+
+// forkQuery は既存のクエリを複製
+func (h *Handler) forkQuery(args map[string]interface{}) mcp.CallToolResult {
+	// query_id の取得
+	queryIDFloat, ok := args["query_id"].(float64)
+	if !ok {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: "query_id must be a number",
+				},
+			},
+			IsError: true,
+		}
+	}
+	queryID := int(queryIDFloat)
+
+	// Redash API を呼び出し
+	forked, err := h.redashClient.ForkQuery(queryID)
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to fork query: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	// JSON として整形して返す
+	formatted, err := json.MarshalIndent(forked, "", "  ")
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to format query: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	return mcp.CallToolResult{
+		Content: []mcp.Content{
+			{
+				Type: "text",
+				Text: string(formatted),
+			},
+		},
+		IsError: false,
+	}
+}
+
+// archiveQuery はクエリをアーカイブ
+func (h *Handler) archiveQuery(args map[string]interface{}) mcp.CallToolResult {
+	// query_id の取得
+	queryIDFloat, ok := args["query_id"].(float64)
+	if !ok {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: "query_id must be a number",
+				},
+			},
+			IsError: true,
+		}
+	}
+	queryID := int(queryIDFloat)
+
+	// 対象のクエリを確認（ログと結果に名前を残すため）
+	query, err := h.redashClient.GetQuery(queryID)
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to get query: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	log.Printf("Archiving query %d (%s)", query.ID, query.Name)
+
+	// Redash API を呼び出し
+	if err := h.redashClient.ArchiveQuery(queryID); err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to archive query: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	// JSON として整形して返す
+	formatted, err := json.MarshalIndent(archiveResult{
+		ID:         query.ID,
+		Name:       query.Name,
+		IsArchived: true,
+	}, "", "  ")
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to format result: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	return mcp.CallToolResult{
+		Content: []mcp.Content{
+			{
+				Type: "text",
+				Text: string(formatted),
+			},
+		},
+		IsError: false,
+	}
+}
+
+// unarchiveQuery はアーカイブ済みのクエリを元に戻す
+func (h *Handler) unarchiveQuery(args map[string]interface{}) mcp.CallToolResult {
+	// query_id の取得
+	queryIDFloat, ok := args["query_id"].(float64)
+	if !ok {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: "query_id must be a number",
+				},
+			},
+			IsError: true,
+		}
+	}
+	queryID := int(queryIDFloat)
+
+	log.Printf("Unarchiving query %d", queryID)
+
+	// Redash API を呼び出し
+	query, err := h.redashClient.UnarchiveQuery(queryID)
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to unarchive query: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	// JSON として整形して返す
+	formatted, err := json.MarshalIndent(archiveResult{
+		ID:         query.ID,
+		Name:       query.Name,
+		IsArchived: query.IsArchived,
+	}, "", "  ")
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to format result: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	return mcp.CallToolResult{
+		Content: []mcp.Content{
+			{
+				Type: "text",
+				Text: string(formatted),
+			},
+		},
+		IsError: false,
+	}
+}
+
+// scheduleResult は set_query_schedule の結果
+type scheduleResult struct {
+	ID       int                   `json:"id"`
+	Name     string                `json:"name"`
+	Schedule *redash.QuerySchedule `json:"schedule"`
+}
+
+// setQuerySchedule はクエリの定期実行のスケジュールを設定
+func (h *Handler) setQuerySchedule(args map[string]interface{}) mcp.CallToolResult {
+	// query_id の取得
+	queryIDFloat, ok := args["query_id"].(float64)
+	if !ok {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: "query_id must be a number",
+				},
+			},
+			IsError: true,
+		}
+	}
+	queryID := int(queryIDFloat)
+
+	// disable の取得（オプション）
+	disable, _ := args["disable"].(bool)
+
+	var schedule *redash.QuerySchedule
+	if !disable {
+		// interval の取得
+		intervalFloat, ok := args["interval"].(float64)
+		if !ok {
+			return mcp.CallToolResult{
+				Content: []mcp.Content{
+					{
+						Type: "text",
+						Text: "interval must be a number of seconds (or set disable to stop the schedule)",
+					},
+				},
+				IsError: true,
+			}
+		}
+		schedule = &redash.QuerySchedule{Interval: int(intervalFloat)}
+
+		// time の取得（オプション）
+		if value, exists := args["time"]; exists {
+			scheduleTime, ok := value.(string)
+			if !ok {
+				return mcp.CallToolResult{
+					Content: []mcp.Content{
+						{
+							Type: "text",
+							Text: "time must be a string in HH:MM format (UTC)",
+						},
+					},
+					IsError: true,
+				}
+			}
+			schedule.Time = &scheduleTime
+		}
+
+		// day_of_week の取得（オプション、大文字小文字を区別しない）
+		if value, exists := args["day_of_week"]; exists {
+			dayOfWeek, ok := value.(string)
+			if !ok {
+				return mcp.CallToolResult{
+					Content: []mcp.Content{
+						{
+							Type: "text",
+							Text: "day_of_week must be a string",
+						},
+					},
+					IsError: true,
+				}
+			}
+			for _, day := range redash.ScheduleDaysOfWeek {
+				if strings.EqualFold(day, dayOfWeek) {
+					dayOfWeek = day
+				}
+			}
+			schedule.DayOfWeek = &dayOfWeek
+		}
+
+		// until の取得（オプション）
+		if value, exists := args["until"]; exists {
+			until, ok := value.(string)
+			if !ok {
+				return mcp.CallToolResult{
+					Content: []mcp.Content{
+						{
+							Type: "text",
+							Text: "until must be a string in YYYY-MM-DD format",
+						},
+					},
+					IsError: true,
+				}
+			}
+			schedule.Until = &until
+		}
+
+		if err := redash.ValidateQuerySchedule(*schedule); err != nil {
+			return mcp.CallToolResult{
+				Content: []mcp.Content{
+					{
+						Type: "text",
+						Text: fmt.Sprintf("Invalid schedule: %v", err),
+					},
+				},
+				IsError: true,
+			}
+		}
+	}
+
+	if schedule == nil {
+		log.Printf("Disabling schedule of query %d", queryID)
+	} else {
+		log.Printf("Setting schedule of query %d (interval %d)", queryID, schedule.Interval)
+	}
+
+	// Redash API を呼び出し
+	query, err := h.redashClient.SetQuerySchedule(queryID, schedule)
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to set query schedule: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	// JSON として整形して返す
+	formatted, err := json.MarshalIndent(scheduleResult{
+		ID:       query.ID,
+		Name:     query.Name,
+		Schedule: query.Schedule,
+	}, "", "  ")
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to format result: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	return mcp.CallToolResult{
+		Content: []mcp.Content{
+			{
+				Type: "text",
+				Text: string(formatted),
+			},
+		},
+		IsError: false,
+	}
+}
@@ -0,0 +1,107 @@
+package tools
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/shshimamo/redash-mcp-go/mcp"
+	"github.com/shshimamo/redash-mcp-go/redash"
+)
+
+// storedQueryResult は get_query_result の結果
+type storedQueryResult struct {
+	QueryResultID int             `json:"query_result_id"`
+	QueryID       int             `json:"query_id,omitempty"`
+	DataSourceID  int             `json:"data_source_id,omitempty"`
+	RetrievedAt   string          `json:"retrieved_at"`
+	Runtime       float64         `json:"runtime"`
+	Columns       []redash.Column `json:"columns"`
+	Rows          json.RawMessage `json:"rows"`
+}
+
+// getQueryResult は保存済みのクエリ結果を取得（クエリは実行しない）
+func (h *Handler) getQueryResult(args map[string]interface{}) mcp.CallToolResult {
+	queryResultIDFloat, hasResultID := args["query_result_id"].(float64)
+	queryIDFloat, hasQueryID := args["query_id"].(float64)
+	if hasResultID == hasQueryID {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: "exactly one of query_result_id or query_id must be specified as a number",
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	// Redash API を呼び出し
+	var queryResult *redash.QueryResult
+	var err error
+	if hasResultID {
+		queryResult, err = h.redashClient.GetQueryResult(int(queryResultIDFloat))
+	} else {
+		// 最新のキャッシュ済み結果をたどる
+		queryResult, err = h.redashClient.GetLatestQueryResult(int(queryIDFloat))
+	}
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to get query result: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	var data redash.QueryResultData
+	if err := json.Unmarshal(queryResult.Data, &data); err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to parse result: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	result := storedQueryResult{
+		QueryResultID: queryResult.ID,
+		DataSourceID:  queryResult.DataSourceID,
+		RetrievedAt:   queryResult.RetrievedAt,
+		Runtime:       queryResult.Runtime,
+		Columns:       data.Columns,
+		Rows:          data.Rows,
+	}
+	if hasQueryID {
+		result.QueryID = int(queryIDFloat)
+	}
+
+	// JSON として整形して返す
+	formatted, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to format result: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	return mcp.CallToolResult{
+		Content: []mcp.Content{
+			{
+				Type: "text",
+				Text: string(formatted),
+			},
+		},
+		IsError: false,
+	}
+}
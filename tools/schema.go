@@ -0,0 +1,102 @@
+package tools
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/shshimamo/redash-mcp-go/mcp"
+	"github.com/shshimamo/redash-mcp-go/redash"
+)
+
+// schemaResult は get_schema の結果
+type schemaResult struct {
+	DataSourceID  int                  `json:"data_source_id"`
+	TotalTables   int                  `json:"total_tables"`
+	MatchedTables int                  `json:"matched_tables"`
+	Tables        []redash.SchemaTable `json:"tables,omitempty"`
+	TableNames    []string             `json:"table_names,omitempty"`
+}
+
+// getSchema はデータソースのスキーマを取得
+func (h *Handler) getSchema(args map[string]interface{}) mcp.CallToolResult {
+	// data_source_id の取得
+	dataSourceIDFloat, ok := args["data_source_id"].(float64)
+	if !ok {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: "data_source_id must be a number",
+				},
+			},
+			IsError: true,
+		}
+	}
+	dataSourceID := int(dataSourceIDFloat)
+
+	// refresh の取得（オプション）
+	refresh, _ := args["refresh"].(bool)
+
+	// table_filter の取得（オプション）
+	tableFilter, _ := args["table_filter"].(string)
+
+	// tables_only の取得（オプション）
+	tablesOnly, _ := args["tables_only"].(bool)
+
+	// Redash API を呼び出し
+	tables, err := h.redashClient.GetSchema(dataSourceID, refresh)
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to get schema: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	// テーブル名で絞り込み（大文字小文字を区別しない部分一致）
+	result := schemaResult{
+		DataSourceID: dataSourceID,
+		TotalTables:  len(tables),
+	}
+	filter := strings.ToLower(tableFilter)
+	for _, table := range tables {
+		if filter != "" && !strings.Contains(strings.ToLower(table.Name), filter) {
+			continue
+		}
+		result.MatchedTables++
+		if tablesOnly {
+			result.TableNames = append(result.TableNames, table.Name)
+		} else {
+			result.Tables = append(result.Tables, table)
+		}
+	}
+
+	// JSON として整形して返す
+	formatted, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to format schema: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	return mcp.CallToolResult{
+		Content: []mcp.Content{
+			{
+				Type: "text",
+				Text: string(formatted),
+			},
+		},
+		IsError: false,
+	}
+}
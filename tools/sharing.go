@@ -0,0 +1,236 @@
+package tools
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/shshimamo/redash-mcp-go/mcp"
+)
+
+// dashboardShareResult は share_dashboard の結果
+type dashboardShareResult struct {
+	DashboardID int    `json:"dashboard_id"`
+	Name        string `json:"name"`
+	PublicURL   string `json:"public_url"`
+}
+
+// queryResultsLinkResult は get_query_results_link の結果
+type queryResultsLinkResult struct {
+	QueryID int    `json:"query_id"`
+	Name    string `json:"name"`
+	Format  string `json:"format"`
+	URL     string `json:"url"`
+}
+
+// shareDashboard はダッシュボードの公開リンクを有効化
+func (h *Handler) shareDashboard(args map[string]interface{}) mcp.CallToolResult {
+	// dashboard_id の取得
+	dashboardIDFloat, ok := args["dashboard_id"].(float64)
+	if !ok {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: "dashboard_id must be a number",
+				},
+			},
+			IsError: true,
+		}
+	}
+	dashboardID := int(dashboardIDFloat)
+
+	dashboard, err := h.redashClient.GetDashboard(dashboardID)
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to get dashboard: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	log.Printf("Sharing dashboard %d (%s) publicly", dashboard.ID, dashboard.Name)
+
+	// Redash API を呼び出し
+	share, err := h.redashClient.ShareDashboard(dashboardID)
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to share dashboard: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	// JSON として整形して返す
+	formatted, err := json.MarshalIndent(dashboardShareResult{
+		DashboardID: dashboard.ID,
+		Name:        dashboard.Name,
+		PublicURL:   share.PublicURL,
+	}, "", "  ")
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to format result: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	return mcp.CallToolResult{
+		Content: []mcp.Content{
+			{
+				Type: "text",
+				Text: string(formatted),
+			},
+		},
+		IsError: false,
+	}
+}
+
+// unshareDashboard はダッシュボードの公開リンクを無効化
+func (h *Handler) unshareDashboard(args map[string]interface{}) mcp.CallToolResult {
+	// dashboard_id の取得
+	dashboardIDFloat, ok := args["dashboard_id"].(float64)
+	if !ok {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: "dashboard_id must be a number",
+				},
+			},
+			IsError: true,
+		}
+	}
+	dashboardID := int(dashboardIDFloat)
+
+	log.Printf("Disabling public link of dashboard %d", dashboardID)
+
+	// Redash API を呼び出し
+	if err := h.redashClient.UnshareDashboard(dashboardID); err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to unshare dashboard: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	return mcp.CallToolResult{
+		Content: []mcp.Content{
+			{
+				Type: "text",
+				Text: fmt.Sprintf("Public link of dashboard %d disabled", dashboardID),
+			},
+		},
+		IsError: false,
+	}
+}
+
+// getQueryResultsLink はクエリ固有の API キーを使った結果の URL を取得
+func (h *Handler) getQueryResultsLink(args map[string]interface{}) mcp.CallToolResult {
+	// query_id の取得
+	queryIDFloat, ok := args["query_id"].(float64)
+	if !ok {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: "query_id must be a number",
+				},
+			},
+			IsError: true,
+		}
+	}
+	queryID := int(queryIDFloat)
+
+	// format の取得（オプション）
+	format := "csv"
+	if value, exists := args["format"]; exists {
+		formatValue, ok := value.(string)
+		if !ok || (formatValue != "csv" && formatValue != "json") {
+			return mcp.CallToolResult{
+				Content: []mcp.Content{
+					{
+						Type: "text",
+						Text: "format must be \"csv\" or \"json\"",
+					},
+				},
+				IsError: true,
+			}
+		}
+		format = formatValue
+	}
+
+	query, err := h.redashClient.GetQuery(queryID)
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to get query: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	log.Printf("Creating results link for query %d (%s)", query.ID, query.Name)
+
+	// Redash API を呼び出し
+	apiKey, err := h.redashClient.GetQueryAPIKey(queryID)
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to get query API key: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	// JSON として整形して返す
+	formatted, err := json.MarshalIndent(queryResultsLinkResult{
+		QueryID: query.ID,
+		Name:    query.Name,
+		Format:  format,
+		URL:     h.redashClient.QueryResultsURL(queryID, apiKey, format),
+	}, "", "  ")
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to format result: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	return mcp.CallToolResult{
+		Content: []mcp.Content{
+			{
+				Type: "text",
+				Text: string(formatted),
+			},
+		},
+		IsError: false,
+	}
+}
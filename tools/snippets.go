@@ -0,0 +1,306 @@
+package tools
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/shshimamo/redash-mcp-go/mcp"
+	"github.com/shshimamo/redash-mcp-go/redash"
+)
+
+// listQuerySnippets はクエリスニペットの一覧を取得
+func (h *Handler) listQuerySnippets(args map[string]interface{}) mcp.CallToolResult {
+	// Redash API を呼び出し
+	snippets, err := h.redashClient.ListQuerySnippets()
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to list query snippets: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	// JSON として整形して返す
+	formatted, err := json.MarshalIndent(snippets, "", "  ")
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to format query snippets: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	return mcp.CallToolResult{
+		Content: []mcp.Content{
+			{
+				Type: "text",
+				Text: string(formatted),
+			},
+		},
+		IsError: false,
+	}
+}
+
+// getQuerySnippet はクエリスニペットを取得
+func (h *Handler) getQuerySnippet(args map[string]interface{}) mcp.CallToolResult {
+	// snippet_id の取得
+	snippetIDFloat, ok := args["snippet_id"].(float64)
+	if !ok {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: "snippet_id must be a number",
+				},
+			},
+			IsError: true,
+		}
+	}
+	snippetID := int(snippetIDFloat)
+
+	// Redash API を呼び出し
+	snippet, err := h.redashClient.GetQuerySnippet(snippetID)
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to get query snippet: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	// JSON として整形して返す
+	formatted, err := json.MarshalIndent(snippet, "", "  ")
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to format query snippet: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	return mcp.CallToolResult{
+		Content: []mcp.Content{
+			{
+				Type: "text",
+				Text: string(formatted),
+			},
+		},
+		IsError: false,
+	}
+}
+
+// createQuerySnippet は新しいクエリスニペットを作成
+func (h *Handler) createQuerySnippet(args map[string]interface{}) mcp.CallToolResult {
+	// trigger の取得
+	trigger, ok := args["trigger"].(string)
+	if !ok || trigger == "" {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: "trigger must be a non-empty string",
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	// snippet の取得
+	snippetText, ok := args["snippet"].(string)
+	if !ok || snippetText == "" {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: "snippet must be a non-empty string",
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	// description の取得（オプション）
+	description, _ := args["description"].(string)
+
+	// Redash API を呼び出し
+	snippet, err := h.redashClient.CreateQuerySnippet(redash.CreateQuerySnippetInput{
+		Trigger:     trigger,
+		Description: description,
+		Snippet:     snippetText,
+	})
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to create query snippet: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	// JSON として整形して返す
+	formatted, err := json.MarshalIndent(snippet, "", "  ")
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to format query snippet: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	return mcp.CallToolResult{
+		Content: []mcp.Content{
+			{
+				Type: "text",
+				Text: string(formatted),
+			},
+		},
+		IsError: false,
+	}
+}
+
+// updateQuerySnippet は既存のクエリスニペットを更新
+func (h *Handler) updateQuerySnippet(args map[string]interface{}) mcp.CallToolResult {
+	// snippet_id の取得
+	snippetIDFloat, ok := args["snippet_id"].(float64)
+	if !ok {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: "snippet_id must be a number",
+				},
+			},
+			IsError: true,
+		}
+	}
+	snippetID := int(snippetIDFloat)
+
+	var input redash.UpdateQuerySnippetInput
+
+	// trigger の取得（オプション）
+	if value, exists := args["trigger"]; exists {
+		trigger, ok := value.(string)
+		if !ok || trigger == "" {
+			return mcp.CallToolResult{
+				Content: []mcp.Content{
+					{
+						Type: "text",
+						Text: "trigger must be a non-empty string",
+					},
+				},
+				IsError: true,
+			}
+		}
+		input.Trigger = &trigger
+	}
+
+	// description の取得（オプション）
+	if value, exists := args["description"]; exists {
+		description, ok := value.(string)
+		if !ok {
+			return mcp.CallToolResult{
+				Content: []mcp.Content{
+					{
+						Type: "text",
+						Text: "description must be a string",
+					},
+				},
+				IsError: true,
+			}
+		}
+		input.Description = &description
+	}
+
+	// snippet の取得（オプション）
+	if value, exists := args["snippet"]; exists {
+		snippetText, ok := value.(string)
+		if !ok || snippetText == "" {
+			return mcp.CallToolResult{
+				Content: []mcp.Content{
+					{
+						Type: "text",
+						Text: "snippet must be a non-empty string",
+					},
+				},
+				IsError: true,
+			}
+		}
+		input.Snippet = &snippetText
+	}
+
+	if input.Trigger == nil && input.Description == nil && input.Snippet == nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: "at least one of trigger, description or snippet must be specified",
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	log.Printf("Updating query snippet %d", snippetID)
+
+	// Redash API を呼び出し
+	snippet, err := h.redashClient.UpdateQuerySnippet(snippetID, input)
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to update query snippet: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	// JSON として整形して返す
+	formatted, err := json.MarshalIndent(snippet, "", "  ")
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to format query snippet: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	return mcp.CallToolResult{
+		Content: []mcp.Content{
+			{
+				Type: "text",
+				Text: string(formatted),
+			},
+		},
+		IsError: false,
+	}
+}
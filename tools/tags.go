@@ -0,0 +1,314 @@
+package tools
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/shshimamo/redash-mcp-go/mcp"
+	"github.com/shshimamo/redash-mcp-go/redash"
+)
+
+// tagsResult は list_tags の結果
+type tagsResult struct {
+	QueryTags     []redash.Tag `json:"query_tags,omitempty"`
+	DashboardTags []redash.Tag `json:"dashboard_tags,omitempty"`
+}
+
+// updateTagsResult は update_tags の結果
+type updateTagsResult struct {
+	ObjectType string   `json:"object_type"`
+	ID         int      `json:"id"`
+	Name       string   `json:"name"`
+	Tags       []string `json:"tags"`
+}
+
+// listTags はクエリ・ダッシュボードのタグの一覧を取得
+func (h *Handler) listTags(args map[string]interface{}) mcp.CallToolResult {
+	// object_type の取得（オプション、未指定の場合は両方）
+	objectType, errMsg := parseObjectType(args, false)
+	if errMsg != "" {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: errMsg,
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	var result tagsResult
+
+	// Redash API を呼び出し
+	if objectType == "" || objectType == objectTypeQuery {
+		tags, err := h.redashClient.ListQueryTags()
+		if err != nil {
+			return mcp.CallToolResult{
+				Content: []mcp.Content{
+					{
+						Type: "text",
+						Text: fmt.Sprintf("Failed to list query tags: %v", err),
+					},
+				},
+				IsError: true,
+			}
+		}
+		result.QueryTags = tags
+	}
+
+	if objectType == "" || objectType == objectTypeDashboard {
+		tags, err := h.redashClient.ListDashboardTags()
+		if err != nil {
+			return mcp.CallToolResult{
+				Content: []mcp.Content{
+					{
+						Type: "text",
+						Text: fmt.Sprintf("Failed to list dashboard tags: %v", err),
+					},
+				},
+				IsError: true,
+			}
+		}
+		result.DashboardTags = tags
+	}
+
+	// JSON として整形して返す
+	formatted, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to format tags: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	return mcp.CallToolResult{
+		Content: []mcp.Content{
+			{
+				Type: "text",
+				Text: string(formatted),
+			},
+		},
+		IsError: false,
+	}
+}
+
+// updateTags はクエリ・ダッシュボードのタグを置き換え・追加・削除
+func (h *Handler) updateTags(args map[string]interface{}) mcp.CallToolResult {
+	// object_type の取得
+	objectType, errMsg := parseObjectType(args, true)
+	if errMsg != "" {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: errMsg,
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	// object_id の取得
+	objectIDFloat, ok := args["object_id"].(float64)
+	if !ok {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: "object_id must be a number",
+				},
+			},
+			IsError: true,
+		}
+	}
+	objectID := int(objectIDFloat)
+
+	// tags の取得（オプション、既存のタグを置き換える）
+	var replace []string
+	hasReplace := false
+	if value, exists := args["tags"]; exists {
+		tags, ok := toStringSlice(value)
+		if !ok {
+			return mcp.CallToolResult{
+				Content: []mcp.Content{
+					{
+						Type: "text",
+						Text: "tags must be an array of strings",
+					},
+				},
+				IsError: true,
+			}
+		}
+		replace = tags
+		hasReplace = true
+	}
+
+	// add の取得（オプション）
+	var add []string
+	if value, exists := args["add"]; exists {
+		tags, ok := toStringSlice(value)
+		if !ok {
+			return mcp.CallToolResult{
+				Content: []mcp.Content{
+					{
+						Type: "text",
+						Text: "add must be an array of strings",
+					},
+				},
+				IsError: true,
+			}
+		}
+		add = tags
+	}
+
+	// remove の取得（オプション）
+	var remove []string
+	if value, exists := args["remove"]; exists {
+		tags, ok := toStringSlice(value)
+		if !ok {
+			return mcp.CallToolResult{
+				Content: []mcp.Content{
+					{
+						Type: "text",
+						Text: "remove must be an array of strings",
+					},
+				},
+				IsError: true,
+			}
+		}
+		remove = tags
+	}
+
+	if !hasReplace && len(add) == 0 && len(remove) == 0 {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: "at least one of tags, add or remove must be specified",
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	// 現在のタグを取得
+	var name string
+	var current []string
+	switch objectType {
+	case objectTypeQuery:
+		query, err := h.redashClient.GetQuery(objectID)
+		if err != nil {
+			return mcp.CallToolResult{
+				Content: []mcp.Content{
+					{
+						Type: "text",
+						Text: fmt.Sprintf("Failed to get query: %v", err),
+					},
+				},
+				IsError: true,
+			}
+		}
+		name, current = query.Name, query.Tags
+	case objectTypeDashboard:
+		dashboard, err := h.redashClient.GetDashboard(objectID)
+		if err != nil {
+			return mcp.CallToolResult{
+				Content: []mcp.Content{
+					{
+						Type: "text",
+						Text: fmt.Sprintf("Failed to get dashboard: %v", err),
+					},
+				},
+				IsError: true,
+			}
+		}
+		name, current = dashboard.Name, dashboard.Tags
+	}
+
+	// 新しいタグを計算（tags で置き換えた後に add を追加し、remove を削除）
+	if hasReplace {
+		current = replace
+	}
+	tagSet := make(map[string]bool)
+	for _, tag := range current {
+		tagSet[tag] = true
+	}
+	for _, tag := range add {
+		tagSet[tag] = true
+	}
+	for _, tag := range remove {
+		delete(tagSet, tag)
+	}
+	tags := make([]string, 0, len(tagSet))
+	for tag := range tagSet {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+
+	log.Printf("Updating tags of %s %d: %v", objectType, objectID, tags)
+
+	// Redash API を呼び出し
+	switch objectType {
+	case objectTypeQuery:
+		if _, err := h.redashClient.UpdateQuery(objectID, redash.UpdateQueryInput{Tags: &tags}); err != nil {
+			return mcp.CallToolResult{
+				Content: []mcp.Content{
+					{
+						Type: "text",
+						Text: fmt.Sprintf("Failed to update query tags: %v", err),
+					},
+				},
+				IsError: true,
+			}
+		}
+	case objectTypeDashboard:
+		if _, err := h.redashClient.UpdateDashboard(objectID, redash.UpdateDashboardInput{Tags: &tags}); err != nil {
+			return mcp.CallToolResult{
+				Content: []mcp.Content{
+					{
+						Type: "text",
+						Text: fmt.Sprintf("Failed to update dashboard tags: %v", err),
+					},
+				},
+				IsError: true,
+			}
+		}
+	}
+
+	// JSON として整形して返す
+	formatted, err := json.MarshalIndent(updateTagsResult{
+		ObjectType: objectType,
+		ID:         objectID,
+		Name:       name,
+		Tags:       tags,
+	}, "", "  ")
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to format result: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	return mcp.CallToolResult{
+		Content: []mcp.Content{
+			{
+				Type: "text",
+				Text: string(formatted),
+			},
+		},
+		IsError: false,
+	}
+}
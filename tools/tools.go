@@ -25,7 +25,7 @@ func (h *Handler) GetTools() []mcp.Tool {
 	return []mcp.Tool{
 		{
 			Name:        "get_query",
-			Description: "Get metadata of a saved Redash query (name, description, SQL, etc.)",
+			Description: "Get metadata of a saved Redash query (name, description, SQL, owner, last modifier, refresh schedule, etc.). parameters lists each parameter's name, type, default value, enum options and global flag; use get_parameter_options for dropdown values",
 			InputSchema: mcp.InputSchema{
 				Type: "object",
 				Properties: map[string]mcp.Property{
@@ -67,7 +67,7 @@ func (h *Handler) GetTools() []mcp.Tool {
 		},
 		{
 			Name:        "execute_query",
-			Description: "Execute a saved Redash query by its ID and return the results",
+			Description: "Execute a saved Redash query by its ID and return the results. The response reports whether the result came from the cache or a new run (source). Waits up to 30 seconds; for long-running queries set async to get a job ID and poll it with get_job_status or wait_for_job",
 			InputSchema: mcp.InputSchema{
 				Type: "object",
 				Properties: map[string]mcp.Property{
@@ -77,28 +77,1383 @@ func (h *Handler) GetTools() []mcp.Tool {
 					},
 					"parameters": {
 						Type:        "object",
-						Description: "Optional parameters for the query (key-value pairs)",
+						Description: "Optional parameters for the query (key-value pairs). Values are checked against the query's parameter definitions (see get_query); omitted parameters use their default values",
+					},
+					"max_age": {
+						Type:        "number",
+						Description: "Maximum age in seconds of a cached result to accept. Use 0 to always run the query and get fresh data (default: Redash decides)",
+					},
+					"async": {
+						Type:        "boolean",
+						Description: "Return the job ID immediately instead of waiting for the result (cached results are still returned directly)",
+					},
+				},
+				Required: []string{"query_id"},
+			},
+		},
+		{
+			Name:        "execute_adhoc_query",
+			Description: "Execute an ad-hoc SQL query directly. Waits up to 30 seconds; for long-running queries set async to get a job ID and poll it with get_job_status or wait_for_job",
+			InputSchema: mcp.InputSchema{
+				Type: "object",
+				Properties: map[string]mcp.Property{
+					"query": {
+						Type:        "string",
+						Description: "The SQL query to execute",
+					},
+					"data_source_id": {
+						Type:        "number",
+						Description: "The ID of the data source to use (see list_data_sources)",
+					},
+					"parameters": {
+						Type:        "object",
+						Description: "Typed values for {{ name }} placeholders in the SQL, keyed by name: {\"name\": {\"type\": \"text|number|date|date-range|enum\", \"value\": ..., \"options\": [...]}}. Values are bound as quoted SQL literals, so never concatenate user-provided values into the SQL and do not wrap placeholders in quotes. date is YYYY-MM-DD; date-range takes {\"start\": ..., \"end\": ...} and is referenced as {{ name.start }} / {{ name.end }}; enum requires options",
+					},
+					"expand_snippets": {
+						Type:        "boolean",
+						Description: "Replace {{> trigger}} references with the SQL of the matching query snippet (see list_query_snippets) before running, so shared definitions are reused as-is",
+					},
+					"async": {
+						Type:        "boolean",
+						Description: "Return the job ID immediately instead of waiting for the result (cached results are still returned directly)",
+					},
+				},
+				Required: []string{"query", "data_source_id"},
+			},
+		},
+		{
+			Name:        "list_queries",
+			Description: "List saved Redash queries with pagination, optionally filtered by text and tags. Returns a compact summary of each query and the total count",
+			InputSchema: mcp.InputSchema{
+				Type: "object",
+				Properties: map[string]mcp.Property{
+					"page": {
+						Type:        "number",
+						Description: "Page number (starting from 1, default: 1)",
+					},
+					"page_size": {
+						Type:        "number",
+						Description: "Number of queries per page (default: 25)",
+					},
+					"q": {
+						Type:        "string",
+						Description: "Optional text to search in query names, descriptions and SQL",
+					},
+					"tags": {
+						Type:        "array",
+						Description: "Optional tags to filter by (queries must have all tags)",
+						Items: &mcp.Property{
+							Type:        "string",
+							Description: "Tag name",
+						},
+					},
+					"favorites_only": {
+						Type:        "boolean",
+						Description: "Only list queries favorited by the API key's user",
+					},
+				},
+			},
+		},
+		{
+			Name:        "search_queries",
+			Description: "Search saved Redash queries by text (name, description and SQL). Returns a compact summary of each matching query and the total count",
+			InputSchema: mcp.InputSchema{
+				Type: "object",
+				Properties: map[string]mcp.Property{
+					"q": {
+						Type:        "string",
+						Description: "Text to search for",
+					},
+					"page": {
+						Type:        "number",
+						Description: "Page number (starting from 1, default: 1)",
+					},
+					"page_size": {
+						Type:        "number",
+						Description: "Number of queries per page (default: 25)",
+					},
+					"tags": {
+						Type:        "array",
+						Description: "Optional tags to filter by (queries must have all tags)",
+						Items: &mcp.Property{
+							Type:        "string",
+							Description: "Tag name",
+						},
+					},
+				},
+				Required: []string{"q"},
+			},
+		},
+		{
+			Name:        "list_data_sources",
+			Description: "List available Redash data sources with their ID, name, type, query syntax and paused state. Use this to find the data_source_id for execute_adhoc_query",
+			InputSchema: mcp.InputSchema{
+				Type:       "object",
+				Properties: map[string]mcp.Property{},
+			},
+		},
+		{
+			Name:        "get_data_source",
+			Description: "Get details of a Redash data source (name, type, query syntax, paused state, queue names and groups). Requires an admin API key",
+			InputSchema: mcp.InputSchema{
+				Type: "object",
+				Properties: map[string]mcp.Property{
+					"data_source_id": {
+						Type:        "number",
+						Description: "The ID of the data source to get",
+					},
+				},
+				Required: []string{"data_source_id"},
+			},
+		},
+		{
+			Name:        "get_schema",
+			Description: "Get the schema (tables and columns, with column types when the data source provides them) of a Redash data source. Use table_filter or tables_only to keep the output small for large warehouses",
+			InputSchema: mcp.InputSchema{
+				Type: "object",
+				Properties: map[string]mcp.Property{
+					"data_source_id": {
+						Type:        "number",
+						Description: "The ID of the data source",
+					},
+					"refresh": {
+						Type:        "boolean",
+						Description: "Refresh the schema instead of using Redash's cached copy (default: false)",
+					},
+					"table_filter": {
+						Type:        "string",
+						Description: "Optional case-insensitive substring to match against table names",
+					},
+					"tables_only": {
+						Type:        "boolean",
+						Description: "Return only table names without columns (default: false)",
+					},
+				},
+				Required: []string{"data_source_id"},
+			},
+		},
+		{
+			Name:        "create_query",
+			Description: "Create a new saved Redash query. New queries are drafts unless publish is true",
+			InputSchema: mcp.InputSchema{
+				Type: "object",
+				Properties: map[string]mcp.Property{
+					"name": {
+						Type:        "string",
+						Description: "The name of the query",
+					},
+					"query": {
+						Type:        "string",
+						Description: "The SQL of the query",
+					},
+					"data_source_id": {
+						Type:        "number",
+						Description: "The ID of the data source to use (see list_data_sources)",
+					},
+					"description": {
+						Type:        "string",
+						Description: "Optional description of the query",
+					},
+					"tags": {
+						Type:        "array",
+						Description: "Optional tags for the query",
+						Items: &mcp.Property{
+							Type:        "string",
+							Description: "Tag name",
+						},
+					},
+					"parameters": {
+						Type:        "array",
+						Description: "Optional parameter definitions stored in options.parameters",
+						Items: &mcp.Property{
+							Type:        "object",
+							Description: "Parameter definition (e.g. {\"name\": \"country\", \"title\": \"Country\", \"type\": \"text\", \"value\": \"JP\"})",
+						},
+					},
+					"publish": {
+						Type:        "boolean",
+						Description: "Publish the query instead of leaving it as a draft (default: false)",
+					},
+				},
+				Required: []string{"name", "query", "data_source_id"},
+			},
+		},
+		{
+			Name:        "update_query",
+			Description: "Update a saved Redash query. Only the specified fields are changed",
+			InputSchema: mcp.InputSchema{
+				Type: "object",
+				Properties: map[string]mcp.Property{
+					"query_id": {
+						Type:        "number",
+						Description: "The ID of the query to update",
+					},
+					"name": {
+						Type:        "string",
+						Description: "New name of the query",
+					},
+					"query": {
+						Type:        "string",
+						Description: "New SQL of the query",
+					},
+					"description": {
+						Type:        "string",
+						Description: "New description of the query",
+					},
+					"tags": {
+						Type:        "array",
+						Description: "New tags of the query (replaces the existing tags)",
+						Items: &mcp.Property{
+							Type:        "string",
+							Description: "Tag name",
+						},
+					},
+					"parameters": {
+						Type:        "array",
+						Description: "New parameter definitions (replaces options.parameters)",
+						Items: &mcp.Property{
+							Type:        "object",
+							Description: "Parameter definition (e.g. {\"name\": \"country\", \"title\": \"Country\", \"type\": \"text\", \"value\": \"JP\"})",
+						},
+					},
+					"is_draft": {
+						Type:        "boolean",
+						Description: "Set to false to publish the query, true to turn it back into a draft",
+					},
+				},
+				Required: []string{"query_id"},
+			},
+		},
+		{
+			Name:        "fork_query",
+			Description: "Fork (duplicate) a saved Redash query into a new query owned by the current user",
+			InputSchema: mcp.InputSchema{
+				Type: "object",
+				Properties: map[string]mcp.Property{
+					"query_id": {
+						Type:        "number",
+						Description: "The ID of the query to fork",
+					},
+				},
+				Required: []string{"query_id"},
+			},
+		},
+		{
+			Name:        "archive_query",
+			Description: "Archive a saved Redash query. Archived queries are hidden from lists and their schedules stop, but they can be restored with unarchive_query",
+			InputSchema: mcp.InputSchema{
+				Type: "object",
+				Properties: map[string]mcp.Property{
+					"query_id": {
+						Type:        "number",
+						Description: "The ID of the query to archive",
+					},
+				},
+				Required: []string{"query_id"},
+			},
+		},
+		{
+			Name:        "unarchive_query",
+			Description: "Restore an archived Redash query",
+			InputSchema: mcp.InputSchema{
+				Type: "object",
+				Properties: map[string]mcp.Property{
+					"query_id": {
+						Type:        "number",
+						Description: "The ID of the query to restore",
+					},
+				},
+				Required: []string{"query_id"},
+			},
+		},
+		{
+			Name:        "archive_dashboard",
+			Description: "Archive a Redash dashboard. Archived dashboards are hidden from lists but can be restored with unarchive_dashboard",
+			InputSchema: mcp.InputSchema{
+				Type: "object",
+				Properties: map[string]mcp.Property{
+					"dashboard_id": {
+						Type:        "number",
+						Description: "The ID of the dashboard to archive",
+					},
+				},
+				Required: []string{"dashboard_id"},
+			},
+		},
+		{
+			Name:        "unarchive_dashboard",
+			Description: "Restore an archived Redash dashboard",
+			InputSchema: mcp.InputSchema{
+				Type: "object",
+				Properties: map[string]mcp.Property{
+					"dashboard_id": {
+						Type:        "number",
+						Description: "The ID of the dashboard to restore",
+					},
+				},
+				Required: []string{"dashboard_id"},
+			},
+		},
+		{
+			Name:        "list_dashboards",
+			Description: "List Redash dashboards with pagination, optionally filtered by name, tags or favorites. Returns the ID, slug, name, tags and widget count of each dashboard and the total count",
+			InputSchema: mcp.InputSchema{
+				Type: "object",
+				Properties: map[string]mcp.Property{
+					"page": {
+						Type:        "number",
+						Description: "Page number (starting from 1, default: 1)",
+					},
+					"page_size": {
+						Type:        "number",
+						Description: "Number of dashboards per page (default: 25)",
+					},
+					"q": {
+						Type:        "string",
+						Description: "Optional text to search in dashboard names",
+					},
+					"tags": {
+						Type:        "array",
+						Description: "Optional tags to filter by (dashboards must have all tags)",
+						Items: &mcp.Property{
+							Type:        "string",
+							Description: "Tag name",
+						},
+					},
+					"favorites_only": {
+						Type:        "boolean",
+						Description: "Only list dashboards marked as favorite by the current user (default: false)",
+					},
+					"include_widget_counts": {
+						Type:        "boolean",
+						Description: "Fetch each dashboard to count its widgets (default: false). This makes one extra request per dashboard; dashboards that cannot be fetched get widget_count_error instead",
+					},
+				},
+			},
+		},
+		{
+			Name:        "create_dashboard",
+			Description: "Create a new Redash dashboard. New dashboards are drafts unless publish is true. Use add_widget to add visualizations and text",
+			InputSchema: mcp.InputSchema{
+				Type: "object",
+				Properties: map[string]mcp.Property{
+					"name": {
+						Type:        "string",
+						Description: "The name of the dashboard",
+					},
+					"publish": {
+						Type:        "boolean",
+						Description: "Publish the dashboard instead of leaving it as a draft (default: false)",
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+		{
+			Name:        "add_widget",
+			Description: "Add a visualization widget or a text (markdown) widget to a dashboard. The dashboard grid is 6 columns wide; by default the widget is placed below the existing widgets",
+			InputSchema: mcp.InputSchema{
+				Type: "object",
+				Properties: map[string]mcp.Property{
+					"dashboard_id": {
+						Type:        "number",
+						Description: "The ID of the dashboard",
+					},
+					"visualization_id": {
+						Type:        "number",
+						Description: "The ID of the visualization to show (omit for a text widget)",
+					},
+					"text": {
+						Type:        "string",
+						Description: "Markdown text for a text widget",
+					},
+					"col": {
+						Type:        "number",
+						Description: "Column of the top-left corner (0-5, default: 0)",
+					},
+					"row": {
+						Type:        "number",
+						Description: "Row of the top-left corner (default: below the existing widgets)",
+					},
+					"size_x": {
+						Type:        "number",
+						Description: "Width in columns (1-6, default: 3 for visualizations, 6 for text)",
+					},
+					"size_y": {
+						Type:        "number",
+						Description: "Height in rows (default: 8 for visualizations, 3 for text)",
+					},
+					"auto_height": {
+						Type:        "boolean",
+						Description: "Let Redash adjust the height to the content (default: false)",
+					},
+				},
+				Required: []string{"dashboard_id"},
+			},
+		},
+		{
+			Name:        "update_widget",
+			Description: "Change the text, position or size of a dashboard widget. Only the specified values are changed",
+			InputSchema: mcp.InputSchema{
+				Type: "object",
+				Properties: map[string]mcp.Property{
+					"dashboard_id": {
+						Type:        "number",
+						Description: "The ID of the dashboard containing the widget",
+					},
+					"widget_id": {
+						Type:        "number",
+						Description: "The ID of the widget to update",
+					},
+					"text": {
+						Type:        "string",
+						Description: "New markdown text (text widgets only)",
+					},
+					"col": {
+						Type:        "number",
+						Description: "New column of the top-left corner (0-5)",
+					},
+					"row": {
+						Type:        "number",
+						Description: "New row of the top-left corner",
+					},
+					"size_x": {
+						Type:        "number",
+						Description: "New width in columns (1-6)",
+					},
+					"size_y": {
+						Type:        "number",
+						Description: "New height in rows",
+					},
+					"auto_height": {
+						Type:        "boolean",
+						Description: "Let Redash adjust the height to the content",
+					},
+				},
+				Required: []string{"dashboard_id", "widget_id"},
+			},
+		},
+		{
+			Name:        "remove_widget",
+			Description: "Remove a widget from its dashboard. The underlying visualization and query are not deleted",
+			InputSchema: mcp.InputSchema{
+				Type: "object",
+				Properties: map[string]mcp.Property{
+					"widget_id": {
+						Type:        "number",
+						Description: "The ID of the widget to remove",
+					},
+				},
+				Required: []string{"widget_id"},
+			},
+		},
+		{
+			Name:        "list_alerts",
+			Description: "List Redash alerts with their state, query, condition (column, op, value) and rearm interval",
+			InputSchema: mcp.InputSchema{
+				Type:       "object",
+				Properties: map[string]mcp.Property{},
+			},
+		},
+		{
+			Name:        "create_alert",
+			Description: "Create a Redash alert that triggers when the value of a column in the first row of a query result matches a condition",
+			InputSchema: mcp.InputSchema{
+				Type: "object",
+				Properties: map[string]mcp.Property{
+					"name": {
+						Type:        "string",
+						Description: "The name of the alert",
+					},
+					"query_id": {
+						Type:        "number",
+						Description: "The ID of the query to watch",
+					},
+					"column": {
+						Type:        "string",
+						Description: "The result column to compare",
+					},
+					"op": {
+						Type:        "string",
+						Description: "The comparison operator",
+						Enum:        []string{">", ">=", "<", "<=", "==", "!="},
+					},
+					"value": {
+						Type:        "number",
+						Description: "The threshold to compare against (a string is also accepted)",
+					},
+					"rearm": {
+						Type:        "number",
+						Description: "Seconds to wait before notifying again while still triggered (omit to notify just once)",
+					},
+					"custom_subject": {
+						Type:        "string",
+						Description: "Optional custom notification subject",
+					},
+					"custom_body": {
+						Type:        "string",
+						Description: "Optional custom notification body",
+					},
+				},
+				Required: []string{"name", "query_id", "column", "op", "value"},
+			},
+		},
+		{
+			Name:        "update_alert",
+			Description: "Update a Redash alert (e.g. tighten a threshold). Only the specified fields are changed",
+			InputSchema: mcp.InputSchema{
+				Type: "object",
+				Properties: map[string]mcp.Property{
+					"alert_id": {
+						Type:        "number",
+						Description: "The ID of the alert to update",
+					},
+					"name": {
+						Type:        "string",
+						Description: "New name of the alert",
+					},
+					"query_id": {
+						Type:        "number",
+						Description: "New query to watch",
+					},
+					"column": {
+						Type:        "string",
+						Description: "New result column to compare",
+					},
+					"op": {
+						Type:        "string",
+						Description: "New comparison operator",
+						Enum:        []string{">", ">=", "<", "<=", "==", "!="},
+					},
+					"value": {
+						Type:        "number",
+						Description: "New threshold (a string is also accepted)",
+					},
+					"rearm": {
+						Type:        "number",
+						Description: "New number of seconds to wait before notifying again",
+					},
+					"custom_subject": {
+						Type:        "string",
+						Description: "New custom notification subject",
+					},
+					"custom_body": {
+						Type:        "string",
+						Description: "New custom notification body",
+					},
+				},
+				Required: []string{"alert_id"},
+			},
+		},
+		{
+			Name:        "mute_alert",
+			Description: "Mute a Redash alert so that it stops sending notifications",
+			InputSchema: mcp.InputSchema{
+				Type: "object",
+				Properties: map[string]mcp.Property{
+					"alert_id": {
+						Type:        "number",
+						Description: "The ID of the alert to mute",
+					},
+				},
+				Required: []string{"alert_id"},
+			},
+		},
+		{
+			Name:        "unmute_alert",
+			Description: "Unmute a Redash alert so that it sends notifications again",
+			InputSchema: mcp.InputSchema{
+				Type: "object",
+				Properties: map[string]mcp.Property{
+					"alert_id": {
+						Type:        "number",
+						Description: "The ID of the alert to unmute",
+					},
+				},
+				Required: []string{"alert_id"},
+			},
+		},
+		{
+			Name:        "delete_alert",
+			Description: "Permanently delete a Redash alert and its subscriptions",
+			InputSchema: mcp.InputSchema{
+				Type: "object",
+				Properties: map[string]mcp.Property{
+					"alert_id": {
+						Type:        "number",
+						Description: "The ID of the alert to delete",
+					},
+				},
+				Required: []string{"alert_id"},
+			},
+		},
+		{
+			Name:        "list_destinations",
+			Description: "List alert destinations (Slack, email, webhooks, etc.) configured in Redash",
+			InputSchema: mcp.InputSchema{
+				Type: "object",
+				Properties: map[string]mcp.Property{
+					"include_types": {
+						Type:        "boolean",
+						Description: "Also return the destination types that can be created and their configuration schema (default: false)",
+					},
+				},
+			},
+		},
+		{
+			Name:        "create_destination",
+			Description: "Create a new alert destination (requires admin). Use list_destinations with include_types to see the available types and their options",
+			InputSchema: mcp.InputSchema{
+				Type: "object",
+				Properties: map[string]mcp.Property{
+					"name": {
+						Type:        "string",
+						Description: "The name of the destination",
+					},
+					"type": {
+						Type:        "string",
+						Description: "The destination type (e.g. slack, email, webhook)",
+					},
+					"options": {
+						Type:        "object",
+						Description: "Type-specific configuration (e.g. {\"url\": \"https://hooks.slack.com/...\"})",
+					},
+				},
+				Required: []string{"name", "type", "options"},
+			},
+		},
+		{
+			Name:        "list_alert_subscriptions",
+			Description: "List who gets notified by an alert: each subscription's user and destination",
+			InputSchema: mcp.InputSchema{
+				Type: "object",
+				Properties: map[string]mcp.Property{
+					"alert_id": {
+						Type:        "number",
+						Description: "The ID of the alert",
+					},
+				},
+				Required: []string{"alert_id"},
+			},
+		},
+		{
+			Name:        "add_alert_subscription",
+			Description: "Subscribe a destination to an alert. Without destination_id, the API key's user is subscribed by email",
+			InputSchema: mcp.InputSchema{
+				Type: "object",
+				Properties: map[string]mcp.Property{
+					"alert_id": {
+						Type:        "number",
+						Description: "The ID of the alert",
+					},
+					"destination_id": {
+						Type:        "number",
+						Description: "The ID of the destination to notify (see list_destinations)",
+					},
+				},
+				Required: []string{"alert_id"},
+			},
+		},
+		{
+			Name:        "remove_alert_subscription",
+			Description: "Remove a subscription from an alert",
+			InputSchema: mcp.InputSchema{
+				Type: "object",
+				Properties: map[string]mcp.Property{
+					"alert_id": {
+						Type:        "number",
+						Description: "The ID of the alert",
+					},
+					"subscription_id": {
+						Type:        "number",
+						Description: "The ID of the subscription to remove (see list_alert_subscriptions)",
+					},
+				},
+				Required: []string{"alert_id", "subscription_id"},
+			},
+		},
+		{
+			Name:        "get_visualization",
+			Description: "Get a visualization of a saved query, including its type and options",
+			InputSchema: mcp.InputSchema{
+				Type: "object",
+				Properties: map[string]mcp.Property{
+					"query_id": {
+						Type:        "number",
+						Description: "The ID of the query the visualization belongs to",
+					},
+					"visualization_id": {
+						Type:        "number",
+						Description: "The ID of the visualization to get",
+					},
+				},
+				Required: []string{"query_id", "visualization_id"},
+			},
+		},
+		{
+			Name:        "create_visualization",
+			Description: "Create a visualization (chart, table, counter, pivot, etc.) for a saved query. Options depend on the type; omitted options use Redash defaults. CHART example: {\"globalSeriesType\": \"line\", \"columnMapping\": {\"day\": \"x\", \"revenue\": \"y\"}}. COUNTER example: {\"counterColName\": \"total\", \"rowNumber\": 1}. PIVOT example: {\"rows\": [\"country\"], \"cols\": [\"month\"], \"vals\": [\"revenue\"], \"aggregatorName\": \"Sum\"}",
+			InputSchema: mcp.InputSchema{
+				Type: "object",
+				Properties: map[string]mcp.Property{
+					"query_id": {
+						Type:        "number",
+						Description: "The ID of the query to visualize",
+					},
+					"type": {
+						Type:        "string",
+						Description: "The visualization type",
+						Enum:        redash.VisualizationTypes,
+					},
+					"name": {
+						Type:        "string",
+						Description: "The name of the visualization",
+					},
+					"description": {
+						Type:        "string",
+						Description: "Optional description of the visualization",
+					},
+					"options": {
+						Type:        "object",
+						Description: "Type-specific options",
+					},
+				},
+				Required: []string{"query_id", "type", "name"},
+			},
+		},
+		{
+			Name:        "update_visualization",
+			Description: "Update a visualization of a saved query. The given options are merged into the current options (top-level keys are replaced)",
+			InputSchema: mcp.InputSchema{
+				Type: "object",
+				Properties: map[string]mcp.Property{
+					"query_id": {
+						Type:        "number",
+						Description: "The ID of the query the visualization belongs to",
+					},
+					"visualization_id": {
+						Type:        "number",
+						Description: "The ID of the visualization to update",
+					},
+					"type": {
+						Type:        "string",
+						Description: "New visualization type",
+						Enum:        redash.VisualizationTypes,
+					},
+					"name": {
+						Type:        "string",
+						Description: "New name of the visualization",
+					},
+					"description": {
+						Type:        "string",
+						Description: "New description of the visualization",
+					},
+					"options": {
+						Type:        "object",
+						Description: "Options to merge into the current options",
+					},
+				},
+				Required: []string{"query_id", "visualization_id"},
+			},
+		},
+		{
+			Name:        "delete_visualization",
+			Description: "Delete a visualization. Dashboard widgets showing it are removed as well",
+			InputSchema: mcp.InputSchema{
+				Type: "object",
+				Properties: map[string]mcp.Property{
+					"visualization_id": {
+						Type:        "number",
+						Description: "The ID of the visualization to delete",
+					},
+				},
+				Required: []string{"visualization_id"},
+			},
+		},
+		{
+			Name:        "get_query_result",
+			Description: "Get a stored query result without executing the query. Pass query_result_id for a specific result, or query_id to read the latest cached result of a saved query (what its dashboards currently show). The response includes retrieved_at",
+			InputSchema: mcp.InputSchema{
+				Type: "object",
+				Properties: map[string]mcp.Property{
+					"query_result_id": {
+						Type:        "number",
+						Description: "The ID of the query result to get",
+					},
+					"query_id": {
+						Type:        "number",
+						Description: "The ID of a saved query whose latest cached result should be returned",
+					},
+				},
+			},
+		},
+		{
+			Name:        "get_job_status",
+			Description: "Get the status of a query execution job started with async execution (pending, started, success, failure or cancelled)",
+			InputSchema: mcp.InputSchema{
+				Type: "object",
+				Properties: map[string]mcp.Property{
+					"job_id": {
+						Type:        "string",
+						Description: "The ID of the job to check",
+					},
+				},
+				Required: []string{"job_id"},
+			},
+		},
+		{
+			Name:        "wait_for_job",
+			Description: "Wait for a query execution job to finish and return its result. If the timeout is reached the job keeps running in Redash and can be waited on again or cancelled with cancel_job",
+			InputSchema: mcp.InputSchema{
+				Type: "object",
+				Properties: map[string]mcp.Property{
+					"job_id": {
+						Type:        "string",
+						Description: "The ID of the job to wait for",
+					},
+					"timeout_seconds": {
+						Type:        "number",
+						Description: "How long to wait in seconds (default: 30, max: 600)",
+					},
+				},
+				Required: []string{"job_id"},
+			},
+		},
+		{
+			Name:        "cancel_job",
+			Description: "Cancel a running query execution job",
+			InputSchema: mcp.InputSchema{
+				Type: "object",
+				Properties: map[string]mcp.Property{
+					"job_id": {
+						Type:        "string",
+						Description: "The ID of the job to cancel",
+					},
+				},
+				Required: []string{"job_id"},
+			},
+		},
+		{
+			Name:        "get_parameter_options",
+			Description: "Get the allowed values of an enum or query-based dropdown parameter of a saved query",
+			InputSchema: mcp.InputSchema{
+				Type: "object",
+				Properties: map[string]mcp.Property{
+					"query_id": {
+						Type:        "number",
+						Description: "The ID of the query that has the parameter",
+					},
+					"parameter_name": {
+						Type:        "string",
+						Description: "The name of the parameter (see get_query)",
+					},
+				},
+				Required: []string{"query_id", "parameter_name"},
+			},
+		},
+		{
+			Name:        "execute_dashboard",
+			Description: "Execute every widget query of a dashboard with dashboard-level parameters and return each widget's result with its widget and visualization IDs. Parameters are mapped onto each widget's query through its parameter mappings; parameters without a mapping take the same-named dashboard parameter only when they are global, otherwise their saved defaults",
+			InputSchema: mcp.InputSchema{
+				Type: "object",
+				Properties: map[string]mcp.Property{
+					"dashboard_id": {
+						Type:        "number",
+						Description: "The ID of the dashboard to execute",
+					},
+					"parameters": {
+						Type:        "object",
+						Description: "Dashboard parameter values (key-value pairs), e.g. {\"country\": \"JP\", \"period\": \"d_last_week\"}",
+					},
+					"max_age": {
+						Type:        "number",
+						Description: "Maximum age in seconds of cached results to accept. Use 0 to always run the queries (default: Redash decides)",
+					},
+				},
+				Required: []string{"dashboard_id"},
+			},
+		},
+		{
+			Name:        "list_users",
+			Description: "List Redash users with their groups",
+			InputSchema: mcp.InputSchema{
+				Type: "object",
+				Properties: map[string]mcp.Property{
+					"page": {
+						Type:        "number",
+						Description: "Page number, starting from 1 (default: 1)",
+					},
+					"page_size": {
+						Type:        "number",
+						Description: "Number of users per page (default: 25)",
+					},
+					"q": {
+						Type:        "string",
+						Description: "Search text matched against user names and emails",
+					},
+					"disabled": {
+						Type:        "boolean",
+						Description: "List disabled users instead of active ones",
+					},
+				},
+			},
+		},
+		{
+			Name:        "get_user",
+			Description: "Get a Redash user by ID, including the groups the user belongs to",
+			InputSchema: mcp.InputSchema{
+				Type: "object",
+				Properties: map[string]mcp.Property{
+					"user_id": {
+						Type:        "number",
+						Description: "The ID of the user to get",
+					},
+				},
+				Required: []string{"user_id"},
+			},
+		},
+		{
+			Name:        "list_groups",
+			Description: "List Redash groups",
+			InputSchema: mcp.InputSchema{
+				Type:       "object",
+				Properties: map[string]mcp.Property{},
+			},
+		},
+		{
+			Name:        "list_group_members",
+			Description: "List the users that belong to a group",
+			InputSchema: mcp.InputSchema{
+				Type: "object",
+				Properties: map[string]mcp.Property{
+					"group_id": {
+						Type:        "number",
+						Description: "The ID of the group (see list_groups)",
+					},
+				},
+				Required: []string{"group_id"},
+			},
+		},
+		{
+			Name:        "list_group_data_sources",
+			Description: "List the data sources a group can access, with view_only showing whether the group can only view results",
+			InputSchema: mcp.InputSchema{
+				Type: "object",
+				Properties: map[string]mcp.Property{
+					"group_id": {
+						Type:        "number",
+						Description: "The ID of the group (see list_groups)",
+					},
+				},
+				Required: []string{"group_id"},
+			},
+		},
+		{
+			Name:        "get_data_source_access",
+			Description: "Get the groups that can access a data source, and optionally their members, to answer who can see the data",
+			InputSchema: mcp.InputSchema{
+				Type: "object",
+				Properties: map[string]mcp.Property{
+					"data_source_id": {
+						Type:        "number",
+						Description: "The ID of the data source",
+					},
+					"include_members": {
+						Type:        "boolean",
+						Description: "Include the users of each group (default: false)",
+					},
+				},
+				Required: []string{"data_source_id"},
+			},
+		},
+		{
+			Name:        "list_query_snippets",
+			Description: "List Redash query snippets (shared SQL fragments) with their trigger, description and SQL. Reference a snippet in execute_adhoc_query as {{> trigger}} with expand_snippets",
+			InputSchema: mcp.InputSchema{
+				Type:       "object",
+				Properties: map[string]mcp.Property{},
+			},
+		},
+		{
+			Name:        "get_query_snippet",
+			Description: "Get a Redash query snippet by ID",
+			InputSchema: mcp.InputSchema{
+				Type: "object",
+				Properties: map[string]mcp.Property{
+					"snippet_id": {
+						Type:        "number",
+						Description: "The ID of the snippet to get",
+					},
+				},
+				Required: []string{"snippet_id"},
+			},
+		},
+		{
+			Name:        "create_query_snippet",
+			Description: "Create a new Redash query snippet",
+			InputSchema: mcp.InputSchema{
+				Type: "object",
+				Properties: map[string]mcp.Property{
+					"trigger": {
+						Type:        "string",
+						Description: "The trigger word used to insert the snippet",
+					},
+					"snippet": {
+						Type:        "string",
+						Description: "The SQL fragment",
+					},
+					"description": {
+						Type:        "string",
+						Description: "What the snippet is for",
+					},
+				},
+				Required: []string{"trigger", "snippet"},
+			},
+		},
+		{
+			Name:        "update_query_snippet",
+			Description: "Update an existing Redash query snippet. Only the specified fields are changed. Snippets are shared, so changes affect everyone who uses them",
+			InputSchema: mcp.InputSchema{
+				Type: "object",
+				Properties: map[string]mcp.Property{
+					"snippet_id": {
+						Type:        "number",
+						Description: "The ID of the snippet to update",
+					},
+					"trigger": {
+						Type:        "string",
+						Description: "New trigger word",
+					},
+					"snippet": {
+						Type:        "string",
+						Description: "New SQL fragment",
+					},
+					"description": {
+						Type:        "string",
+						Description: "New description",
+					},
+				},
+				Required: []string{"snippet_id"},
+			},
+		},
+		{
+			Name:        "add_favorite",
+			Description: "Add a query or dashboard to the API key user's favorites",
+			InputSchema: mcp.InputSchema{
+				Type: "object",
+				Properties: map[string]mcp.Property{
+					"object_type": {
+						Type:        "string",
+						Description: "The type of object: query or dashboard",
+					},
+					"object_id": {
+						Type:        "number",
+						Description: "The ID of the query or dashboard",
+					},
+				},
+				Required: []string{"object_type", "object_id"},
+			},
+		},
+		{
+			Name:        "remove_favorite",
+			Description: "Remove a query or dashboard from the API key user's favorites",
+			InputSchema: mcp.InputSchema{
+				Type: "object",
+				Properties: map[string]mcp.Property{
+					"object_type": {
+						Type:        "string",
+						Description: "The type of object: query or dashboard",
+					},
+					"object_id": {
+						Type:        "number",
+						Description: "The ID of the query or dashboard",
+					},
+				},
+				Required: []string{"object_type", "object_id"},
+			},
+		},
+		{
+			Name:        "list_favorites",
+			Description: "List the API key user's favorite queries and dashboards",
+			InputSchema: mcp.InputSchema{
+				Type: "object",
+				Properties: map[string]mcp.Property{
+					"object_type": {
+						Type:        "string",
+						Description: "Only list favorites of this type: query or dashboard (default: both)",
+					},
+					"page": {
+						Type:        "number",
+						Description: "Page number (starting from 1, default: 1)",
+					},
+					"page_size": {
+						Type:        "number",
+						Description: "Number of items per page (default: 25)",
+					},
+				},
+			},
+		},
+		{
+			Name:        "list_tags",
+			Description: "List the tags used on queries and dashboards with the number of objects that have each tag",
+			InputSchema: mcp.InputSchema{
+				Type: "object",
+				Properties: map[string]mcp.Property{
+					"object_type": {
+						Type:        "string",
+						Description: "Only list tags of this type: query or dashboard (default: both)",
+					},
+				},
+			},
+		},
+		{
+			Name:        "update_tags",
+			Description: "Re-tag a query or dashboard. Pass tags to replace all tags, and/or add and remove to change individual tags. Check list_tags first to reuse existing tag names",
+			InputSchema: mcp.InputSchema{
+				Type: "object",
+				Properties: map[string]mcp.Property{
+					"object_type": {
+						Type:        "string",
+						Description: "The type of object: query or dashboard",
+					},
+					"object_id": {
+						Type:        "number",
+						Description: "The ID of the query or dashboard",
+					},
+					"tags": {
+						Type:        "array",
+						Description: "Tags that replace the current tags",
+						Items: &mcp.Property{
+							Type:        "string",
+							Description: "Tag name",
+						},
+					},
+					"add": {
+						Type:        "array",
+						Description: "Tags to add",
+						Items: &mcp.Property{
+							Type:        "string",
+							Description: "Tag name",
+						},
+					},
+					"remove": {
+						Type:        "array",
+						Description: "Tags to remove",
+						Items: &mcp.Property{
+							Type:        "string",
+							Description: "Tag name",
+						},
+					},
+				},
+				Required: []string{"object_type", "object_id"},
+			},
+		},
+		{
+			Name:        "set_query_schedule",
+			Description: "Set or stop the refresh schedule of a saved query. Use intervals under a day (e.g. 3600 for hourly) alone, 86400 with time for daily, or 604800 with time and day_of_week for weekly. The query must not be a draft for Redash to run it",
+			InputSchema: mcp.InputSchema{
+				Type: "object",
+				Properties: map[string]mcp.Property{
+					"query_id": {
+						Type:        "number",
+						Description: "The ID of the query to schedule",
+					},
+					"interval": {
+						Type:        "number",
+						Description: "Refresh interval in seconds (minimum 60; a multiple of 86400 for daily or longer)",
+					},
+					"time": {
+						Type:        "string",
+						Description: "Time of day to refresh in UTC (HH:MM), required for daily and weekly schedules",
+					},
+					"day_of_week": {
+						Type:        "string",
+						Description: "Day to refresh on for weekly schedules (Monday to Sunday)",
+					},
+					"until": {
+						Type:        "string",
+						Description: "Stop refreshing after this date (YYYY-MM-DD)",
+					},
+					"disable": {
+						Type:        "boolean",
+						Description: "Stop the schedule instead of setting one",
 					},
 				},
 				Required: []string{"query_id"},
 			},
 		},
 		{
-			Name:        "execute_adhoc_query",
-			Description: "Execute an ad-hoc SQL query directly",
+			Name:        "share_dashboard",
+			Description: "OUTWARD-FACING: Enable the public link of a dashboard and return its URL. Anyone with the link can view the dashboard without logging in to Redash. Only use when the user explicitly asks to share outside Redash",
 			InputSchema: mcp.InputSchema{
 				Type: "object",
 				Properties: map[string]mcp.Property{
-					"query": {
+					"dashboard_id": {
+						Type:        "number",
+						Description: "The ID of the dashboard to share",
+					},
+				},
+				Required: []string{"dashboard_id"},
+			},
+		},
+		{
+			Name:        "unshare_dashboard",
+			Description: "Disable the public link of a dashboard. Existing links stop working",
+			InputSchema: mcp.InputSchema{
+				Type: "object",
+				Properties: map[string]mcp.Property{
+					"dashboard_id": {
+						Type:        "number",
+						Description: "The ID of the dashboard to unshare",
+					},
+				},
+				Required: []string{"dashboard_id"},
+			},
+		},
+		{
+			Name:        "get_query_results_link",
+			Description: "OUTWARD-FACING: Get a URL that downloads the latest results of a saved query as CSV or JSON. The URL embeds the query's own API key, so anyone with it can read the results without logging in to Redash. Only use when the user explicitly asks for a link to share",
+			InputSchema: mcp.InputSchema{
+				Type: "object",
+				Properties: map[string]mcp.Property{
+					"query_id": {
+						Type:        "number",
+						Description: "The ID of the query",
+					},
+					"format": {
 						Type:        "string",
-						Description: "The SQL query to execute",
+						Description: "Result format: csv or json (default: csv)",
 					},
-					"data_source_id": {
+				},
+				Required: []string{"query_id"},
+			},
+		},
+		{
+			Name:        "get_permissions",
+			Description: "Get who can modify a query or dashboard: its owner and the users granted modify access. Admins can always modify",
+			InputSchema: mcp.InputSchema{
+				Type: "object",
+				Properties: map[string]mcp.Property{
+					"object_type": {
+						Type:        "string",
+						Description: "The type of object: query or dashboard",
+					},
+					"object_id": {
 						Type:        "number",
-						Description: "The ID of the data source to use",
+						Description: "The ID of the query or dashboard",
 					},
 				},
-				Required: []string{"query", "data_source_id"},
+				Required: []string{"object_type", "object_id"},
+			},
+		},
+		{
+			Name:        "grant_modify_access",
+			Description: "Allow a user to modify a query or dashboard",
+			InputSchema: mcp.InputSchema{
+				Type: "object",
+				Properties: map[string]mcp.Property{
+					"object_type": {
+						Type:        "string",
+						Description: "The type of object: query or dashboard",
+					},
+					"object_id": {
+						Type:        "number",
+						Description: "The ID of the query or dashboard",
+					},
+					"user_id": {
+						Type:        "number",
+						Description: "The ID of the user to grant access to (see list_users)",
+					},
+				},
+				Required: []string{"object_type", "object_id", "user_id"},
+			},
+		},
+		{
+			Name:        "revoke_modify_access",
+			Description: "Remove a user's modify access to a query or dashboard. The owner and admins keep their access",
+			InputSchema: mcp.InputSchema{
+				Type: "object",
+				Properties: map[string]mcp.Property{
+					"object_type": {
+						Type:        "string",
+						Description: "The type of object: query or dashboard",
+					},
+					"object_id": {
+						Type:        "number",
+						Description: "The ID of the query or dashboard",
+					},
+					"user_id": {
+						Type:        "number",
+						Description: "The ID of the user to revoke access from",
+					},
+				},
+				Required: []string{"object_type", "object_id", "user_id"},
+			},
+		},
+		{
+			Name:        "list_events",
+			Description: "Search the Redash audit log (admin only), newest first. Filters are applied while paging back through /api/events until an event older than since is reached or max_pages is scanned; check complete in the result",
+			InputSchema: mcp.InputSchema{
+				Type: "object",
+				Properties: map[string]mcp.Property{
+					"user_id": {
+						Type:        "number",
+						Description: "Only events by this user",
+					},
+					"group_id": {
+						Type:        "number",
+						Description: "Only events by members of this group (see list_groups)",
+					},
+					"action": {
+						Type:        "string",
+						Description: "Only events with this action, e.g. view, execute, execute_query, create, edit, delete, login",
+					},
+					"object_type": {
+						Type:        "string",
+						Description: "Only events on this type of object, e.g. query, dashboard, data_source, alert, user",
+					},
+					"object_id": {
+						Type:        "string",
+						Description: "Only events on the object with this ID (use with object_type)",
+					},
+					"since": {
+						Type:        "string",
+						Description: "Only events at or after this time: relative (24h, 7d), RFC 3339, or YYYY-MM-DD in UTC. Strongly recommended so paging can stop early",
+					},
+					"until": {
+						Type:        "string",
+						Description: "Only events before this time: relative (24h, 7d), RFC 3339, or YYYY-MM-DD in UTC (the whole day is included)",
+					},
+					"limit": {
+						Type:        "number",
+						Description: "Maximum number of events to return (default: 50, max: 500)",
+					},
+					"max_pages": {
+						Type:        "number",
+						Description: "Maximum number of pages of 100 events to scan (default: 10, max: 50)",
+					},
+				},
+			},
+		},
+		{
+			Name:        "get_queue_status",
+			Description: "Get the state of Redash's job queues and workers (admin only): running and queued job counts per queue, running jobs with their runtime (longest first), and worker states. Runaway jobs can be stopped with cancel_job using their job_id",
+			InputSchema: mcp.InputSchema{
+				Type: "object",
+				Properties: map[string]mcp.Property{
+					"min_runtime_seconds": {
+						Type:        "number",
+						Description: "Only list running jobs that have been running for at least this many seconds",
+					},
+				},
+			},
+		},
+		{
+			Name:        "list_outdated_queries",
+			Description: "List scheduled queries whose results are overdue for a refresh (admin only), as shown on Redash's admin Outdated Queries page",
+			InputSchema: mcp.InputSchema{
+				Type:       "object",
+				Properties: map[string]mcp.Property{},
 			},
 		},
 	}
@@ -117,6 +1472,132 @@ func (h *Handler) CallTool(name string, arguments map[string]interface{}) mcp.Ca
 		return h.executeQuery(arguments)
 	case "execute_adhoc_query":
 		return h.executeAdhocQuery(arguments)
+	case "list_queries":
+		return h.listQueries(arguments)
+	case "search_queries":
+		return h.searchQueries(arguments)
+	case "list_data_sources":
+		return h.listDataSources(arguments)
+	case "get_data_source":
+		return h.getDataSource(arguments)
+	case "get_schema":
+		return h.getSchema(arguments)
+	case "create_query":
+		return h.createQuery(arguments)
+	case "update_query":
+		return h.updateQuery(arguments)
+	case "fork_query":
+		return h.forkQuery(arguments)
+	case "archive_query":
+		return h.archiveQuery(arguments)
+	case "unarchive_query":
+		return h.unarchiveQuery(arguments)
+	case "archive_dashboard":
+		return h.archiveDashboard(arguments)
+	case "unarchive_dashboard":
+		return h.unarchiveDashboard(arguments)
+	case "list_dashboards":
+		return h.listDashboards(arguments)
+	case "create_dashboard":
+		return h.createDashboard(arguments)
+	case "add_widget":
+		return h.addWidget(arguments)
+	case "update_widget":
+		return h.updateWidget(arguments)
+	case "remove_widget":
+		return h.removeWidget(arguments)
+	case "list_alerts":
+		return h.listAlerts(arguments)
+	case "create_alert":
+		return h.createAlert(arguments)
+	case "update_alert":
+		return h.updateAlert(arguments)
+	case "mute_alert":
+		return h.muteAlert(arguments)
+	case "unmute_alert":
+		return h.unmuteAlert(arguments)
+	case "delete_alert":
+		return h.deleteAlert(arguments)
+	case "list_destinations":
+		return h.listDestinations(arguments)
+	case "create_destination":
+		return h.createDestination(arguments)
+	case "list_alert_subscriptions":
+		return h.listAlertSubscriptions(arguments)
+	case "add_alert_subscription":
+		return h.addAlertSubscription(arguments)
+	case "remove_alert_subscription":
+		return h.removeAlertSubscription(arguments)
+	case "get_visualization":
+		return h.getVisualization(arguments)
+	case "create_visualization":
+		return h.createVisualization(arguments)
+	case "update_visualization":
+		return h.updateVisualization(arguments)
+	case "delete_visualization":
+		return h.deleteVisualization(arguments)
+	case "get_query_result":
+		return h.getQueryResult(arguments)
+	case "get_job_status":
+		return h.getJobStatus(arguments)
+	case "wait_for_job":
+		return h.waitForJob(arguments)
+	case "cancel_job":
+		return h.cancelJob(arguments)
+	case "get_parameter_options":
+		return h.getParameterOptions(arguments)
+	case "execute_dashboard":
+		return h.executeDashboard(arguments)
+	case "list_users":
+		return h.listUsers(arguments)
+	case "get_user":
+		return h.getUser(arguments)
+	case "list_groups":
+		return h.listGroups(arguments)
+	case "list_group_members":
+		return h.listGroupMembers(arguments)
+	case "list_group_data_sources":
+		return h.listGroupDataSources(arguments)
+	case "get_data_source_access":
+		return h.getDataSourceAccess(arguments)
+	case "list_query_snippets":
+		return h.listQuerySnippets(arguments)
+	case "get_query_snippet":
+		return h.getQuerySnippet(arguments)
+	case "create_query_snippet":
+		return h.createQuerySnippet(arguments)
+	case "update_query_snippet":
+		return h.updateQuerySnippet(arguments)
+	case "add_favorite":
+		return h.setFavorite(arguments, true)
+	case "remove_favorite":
+		return h.setFavorite(arguments, false)
+	case "list_favorites":
+		return h.listFavorites(arguments)
+	case "list_tags":
+		return h.listTags(arguments)
+	case "update_tags":
+		return h.updateTags(arguments)
+	case "set_query_schedule":
+		return h.setQuerySchedule(arguments)
+	case "share_dashboard":
+		return h.shareDashboard(arguments)
+	case "unshare_dashboard":
+		return h.unshareDashboard(arguments)
+	case "get_query_results_link":
+		return h.getQueryResultsLink(arguments)
+	case "get_permissions":
+		return h.getPermissions(arguments)
+	case "grant_modify_access":
+		return h.setModifyAccess(arguments, true)
+	case "revoke_modify_access":
+		return h.setModifyAccess(arguments, false)
+	case "list_events":
+		return h.listEvents(arguments)
+	case "get_queue_status":
+		return h.getQueueStatus(arguments)
+	case "list_outdated_queries":
+		return h.listOutdatedQueries(arguments)
 	default:
 		return mcp.CallToolResult{
 			Content: []mcp.Content{
@@ -161,8 +1642,22 @@ func (h *Handler) getQuery(args map[string]interface{}) mcp.CallToolResult {
 		}
 	}
 
+	// パラメータ定義を型付きで取り出す
+	parameters, err := query.Parameters()
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to parse query parameters: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
 	// JSON として整形して返す
-	formatted, err := json.MarshalIndent(query, "", "  ")
+	formatted, err := json.MarshalIndent(queryDetail{Query: query, Parameters: parameters}, "", "  ")
 	if err != nil {
 		return mcp.CallToolResult{
 			Content: []mcp.Content{
@@ -321,8 +1816,53 @@ func (h *Handler) executeQuery(args map[string]interface{}) mcp.CallToolResult {
 		parameters = params
 	}
 
+	// クエリのパラメータ定義に沿って検証し、指定のないものはデフォルト値で補う
+	parameters, errResult := h.validateQueryParameters(queryID, parameters)
+	if errResult != nil {
+		return *errResult
+	}
+
+	// max_age の取得（オプション）
+	var maxAge *int
+	if value, exists := args["max_age"]; exists {
+		maxAgeFloat, ok := value.(float64)
+		if !ok || maxAgeFloat < 0 {
+			return mcp.CallToolResult{
+				Content: []mcp.Content{
+					{
+						Type: "text",
+						Text: "max_age must be a non-negative number",
+					},
+				},
+				IsError: true,
+			}
+		}
+		maxAgeInt := int(maxAgeFloat)
+		maxAge = &maxAgeInt
+	}
+
+	// async の取得（オプション）
+	async, _ := args["async"].(bool)
+
+	// 非同期実行の場合はジョブの完了を待たずに返す
+	if async {
+		started, err := h.redashClient.StartQuery(queryID, parameters, maxAge)
+		if err != nil {
+			return mcp.CallToolResult{
+				Content: []mcp.Content{
+					{
+						Type: "text",
+						Text: fmt.Sprintf("Failed to execute query: %v", err),
+					},
+				},
+				IsError: true,
+			}
+		}
+		return h.startedQueryResult(started, true)
+	}
+
 	// Redash API を呼び出し
-	result, err := h.redashClient.ExecuteQuery(queryID, parameters)
+	execution, err := h.redashClient.ExecuteQuery(queryID, parameters, maxAge)
 	if err != nil {
 		return mcp.CallToolResult{
 			Content: []mcp.Content{
@@ -335,8 +1875,8 @@ func (h *Handler) executeQuery(args map[string]interface{}) mcp.CallToolResult {
 		}
 	}
 
-	// 結果を整形
-	formatted, err := h.formatQueryResult(result)
+	// 結果の取得元（キャッシュまたは新規ジョブ）を付けて整形
+	formatted, err := h.formatQueryExecution(execution.Source, execution.JobID, execution.Data)
 	if err != nil {
 		return mcp.CallToolResult{
 			Content: []mcp.Content{
@@ -391,8 +1931,89 @@ func (h *Handler) executeAdhocQuery(args map[string]interface{}) mcp.CallToolRes
 	}
 	dataSourceID := int(dataSourceIDFloat)
 
+	// expand_snippets の取得（オプション）
+	// パラメータはスニペット内でも使えるため、先にスニペットを展開する
+	if expandSnippets, _ := args["expand_snippets"].(bool); expandSnippets {
+		snippets, err := h.redashClient.ListQuerySnippets()
+		if err != nil {
+			return mcp.CallToolResult{
+				Content: []mcp.Content{
+					{
+						Type: "text",
+						Text: fmt.Sprintf("Failed to list query snippets: %v", err),
+					},
+				},
+				IsError: true,
+			}
+		}
+		expanded, err := redash.ExpandSnippets(query, snippets)
+		if err != nil {
+			return mcp.CallToolResult{
+				Content: []mcp.Content{
+					{
+						Type: "text",
+						Text: fmt.Sprintf("Failed to expand snippets: %v", err),
+					},
+				},
+				IsError: true,
+			}
+		}
+		query = expanded
+	}
+
+	// parameters の取得（オプション）
+	// 値は SQL に文字列連結せず、型に応じたリテラルに変換して Redash のパラメータとして渡す
+	var parameters map[string]interface{}
+	if value, exists := args["parameters"]; exists {
+		declared, errMsg := parseAdhocParameters(value)
+		if errMsg != "" {
+			return mcp.CallToolResult{
+				Content: []mcp.Content{
+					{
+						Type: "text",
+						Text: errMsg,
+					},
+				},
+				IsError: true,
+			}
+		}
+		rendered, err := redash.RenderAdhocParameters(query, declared)
+		if err != nil {
+			return mcp.CallToolResult{
+				Content: []mcp.Content{
+					{
+						Type: "text",
+						Text: fmt.Sprintf("Invalid parameters: %v", err),
+					},
+				},
+				IsError: true,
+			}
+		}
+		parameters = rendered
+	}
+
+	// async の取得（オプション）
+	async, _ := args["async"].(bool)
+
+	// 非同期実行の場合はジョブの完了を待たずに返す
+	if async {
+		started, err := h.redashClient.StartAdhocQuery(query, dataSourceID, parameters)
+		if err != nil {
+			return mcp.CallToolResult{
+				Content: []mcp.Content{
+					{
+						Type: "text",
+						Text: fmt.Sprintf("Failed to execute query: %v", err),
+					},
+				},
+				IsError: true,
+			}
+		}
+		return h.startedQueryResult(started, false)
+	}
+
 	// Redash API を呼び出し
-	result, err := h.redashClient.ExecuteAdhocQuery(query, dataSourceID)
+	result, err := h.redashClient.ExecuteAdhocQuery(query, dataSourceID, parameters)
 	if err != nil {
 		return mcp.CallToolResult{
 			Content: []mcp.Content{
@@ -445,3 +2066,92 @@ func (h *Handler) formatQueryResult(result json.RawMessage) (string, error) {
 
 	return string(formatted), nil
 }
+
+// formatQueryExecution は execute_query の結果を取得元を付けて JSON に整形
+func (h *Handler) formatQueryExecution(source, jobID string, result json.RawMessage) (string, error) {
+	var data redash.QueryResultData
+	if err := json.Unmarshal(result, &data); err != nil {
+		return "", fmt.Errorf("failed to parse result: %w", err)
+	}
+
+	// JSON として整形して返す
+	formatted, err := json.MarshalIndent(queryExecutionResult{
+		Source:  source,
+		JobID:   jobID,
+		Columns: data.Columns,
+		Rows:    data.Rows,
+	}, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to format result: %w", err)
+	}
+
+	return string(formatted), nil
+}
+
+// queryDetail は get_query の結果
+// options.parameters を型付きにしたものを parameters として含める
+type queryDetail struct {
+	*redash.Query
+	Parameters []redash.QueryParameter `json:"parameters"`
+}
+
+// queryExecutionResult は execute_query の結果
+// source は結果の取得元（cache: キャッシュ済みの結果、job: 新規に実行した結果）
+type queryExecutionResult struct {
+	Source  string          `json:"source"`
+	JobID   string          `json:"job_id,omitempty"`
+	Columns []redash.Column `json:"columns"`
+	Rows    json.RawMessage `json:"rows"`
+}
+
+// archiveResult はアーカイブ操作の結果
+type archiveResult struct {
+	ID         int    `json:"id"`
+	Name       string `json:"name"`
+	IsArchived bool   `json:"is_archived"`
+}
+
+// toStringSlice は JSON の配列引数を文字列のスライスに変換
+func toStringSlice(value interface{}) ([]string, bool) {
+	items, ok := value.([]interface{})
+	if !ok {
+		return nil, false
+	}
+
+	result := make([]string, 0, len(items))
+	for _, item := range items {
+		str, ok := item.(string)
+		if !ok {
+			return nil, false
+		}
+		result = append(result, str)
+	}
+
+	return result, true
+}
+
+// object_type 引数の値
+const (
+	objectTypeQuery     = "query"
+	objectTypeDashboard = "dashboard"
+)
+
+// parseObjectType は object_type 引数を解釈
+// required が false の場合、未指定なら空文字列を返す
+// 不正な引数がある場合はエラーメッセージを返す
+func parseObjectType(args map[string]interface{}, required bool) (string, string) {
+	value, exists := args["object_type"]
+	if !exists {
+		if required {
+			return "", "object_type must be \"query\" or \"dashboard\""
+		}
+		return "", ""
+	}
+
+	objectType, ok := value.(string)
+	if !ok || (objectType != objectTypeQuery && objectType != objectTypeDashboard) {
+		return "", "object_type must be \"query\" or \"dashboard\""
+	}
+
+	return objectType, ""
+}
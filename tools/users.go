@@ -0,0 +1,449 @@
+package tools
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/shshimamo/redash-mcp-go/mcp"
+	"github.com/shshimamo/redash-mcp-go/redash"
+)
+
+// dataSourceAccess は get_data_source_access の結果
+type dataSourceAccess struct {
+	DataSourceID int                     `json:"data_source_id"`
+	Name         string                  `json:"name"`
+	Groups       []dataSourceGroupAccess `json:"groups"`
+}
+
+// dataSourceGroupAccess はデータソースにアクセスできるグループ
+type dataSourceGroupAccess struct {
+	GroupID  int           `json:"group_id"`
+	Name     string        `json:"name"`
+	ViewOnly bool          `json:"view_only"`
+	Members  []redash.User `json:"members,omitempty"`
+}
+
+// listUsers はユーザーの一覧を取得
+func (h *Handler) listUsers(args map[string]interface{}) mcp.CallToolResult {
+	var opts redash.UserListOptions
+
+	// page の取得（オプション）
+	if value, exists := args["page"]; exists {
+		page, ok := value.(float64)
+		if !ok || page < 1 {
+			return mcp.CallToolResult{
+				Content: []mcp.Content{
+					{
+						Type: "text",
+						Text: "page must be a positive number",
+					},
+				},
+				IsError: true,
+			}
+		}
+		opts.Page = int(page)
+	}
+
+	// page_size の取得（オプション）
+	if value, exists := args["page_size"]; exists {
+		pageSize, ok := value.(float64)
+		if !ok || pageSize < 1 {
+			return mcp.CallToolResult{
+				Content: []mcp.Content{
+					{
+						Type: "text",
+						Text: "page_size must be a positive number",
+					},
+				},
+				IsError: true,
+			}
+		}
+		opts.PageSize = int(pageSize)
+	}
+
+	// q の取得（オプション）
+	opts.Search, _ = args["q"].(string)
+
+	// disabled の取得（オプション）
+	opts.Disabled, _ = args["disabled"].(bool)
+
+	// Redash API を呼び出し
+	list, err := h.redashClient.ListUsers(opts)
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to list users: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	// JSON として整形して返す
+	formatted, err := json.MarshalIndent(list, "", "  ")
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to format users: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	return mcp.CallToolResult{
+		Content: []mcp.Content{
+			{
+				Type: "text",
+				Text: string(formatted),
+			},
+		},
+		IsError: false,
+	}
+}
+
+// getUser はユーザーの詳細を取得
+func (h *Handler) getUser(args map[string]interface{}) mcp.CallToolResult {
+	// user_id の取得
+	userIDFloat, ok := args["user_id"].(float64)
+	if !ok {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: "user_id must be a number",
+				},
+			},
+			IsError: true,
+		}
+	}
+	userID := int(userIDFloat)
+
+	// Redash API を呼び出し
+	user, err := h.redashClient.GetUser(userID)
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to get user: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	// グループIDにグループ名を補う
+	if len(user.Groups) > 0 {
+		groups, err := h.redashClient.ListGroups()
+		if err == nil {
+			names := make(map[int]string, len(groups))
+			for _, group := range groups {
+				names[group.ID] = group.Name
+			}
+			for i := range user.Groups {
+				if user.Groups[i].Name == "" {
+					user.Groups[i].Name = names[user.Groups[i].ID]
+				}
+			}
+		}
+	}
+
+	// JSON として整形して返す
+	formatted, err := json.MarshalIndent(user, "", "  ")
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to format user: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	return mcp.CallToolResult{
+		Content: []mcp.Content{
+			{
+				Type: "text",
+				Text: string(formatted),
+			},
+		},
+		IsError: false,
+	}
+}
+
+// listGroups はグループの一覧を取得
+func (h *Handler) listGroups(args map[string]interface{}) mcp.CallToolResult {
+	// Redash API を呼び出し
+	groups, err := h.redashClient.ListGroups()
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to list groups: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	// JSON として整形して返す
+	formatted, err := json.MarshalIndent(groups, "", "  ")
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to format groups: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	return mcp.CallToolResult{
+		Content: []mcp.Content{
+			{
+				Type: "text",
+				Text: string(formatted),
+			},
+		},
+		IsError: false,
+	}
+}
+
+// listGroupMembers はグループに所属するユーザーの一覧を取得
+func (h *Handler) listGroupMembers(args map[string]interface{}) mcp.CallToolResult {
+	// group_id の取得
+	groupIDFloat, ok := args["group_id"].(float64)
+	if !ok {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: "group_id must be a number",
+				},
+			},
+			IsError: true,
+		}
+	}
+	groupID := int(groupIDFloat)
+
+	// Redash API を呼び出し
+	members, err := h.redashClient.ListGroupMembers(groupID)
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to list group members: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	// JSON として整形して返す
+	formatted, err := json.MarshalIndent(members, "", "  ")
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to format group members: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	return mcp.CallToolResult{
+		Content: []mcp.Content{
+			{
+				Type: "text",
+				Text: string(formatted),
+			},
+		},
+		IsError: false,
+	}
+}
+
+// listGroupDataSources はグループがアクセスできるデータソースの一覧を取得
+func (h *Handler) listGroupDataSources(args map[string]interface{}) mcp.CallToolResult {
+	// group_id の取得
+	groupIDFloat, ok := args["group_id"].(float64)
+	if !ok {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: "group_id must be a number",
+				},
+			},
+			IsError: true,
+		}
+	}
+	groupID := int(groupIDFloat)
+
+	// Redash API を呼び出し
+	dataSources, err := h.redashClient.ListGroupDataSources(groupID)
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to list group data sources: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	// JSON として整形して返す
+	formatted, err := json.MarshalIndent(dataSources, "", "  ")
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to format data sources: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	return mcp.CallToolResult{
+		Content: []mcp.Content{
+			{
+				Type: "text",
+				Text: string(formatted),
+			},
+		},
+		IsError: false,
+	}
+}
+
+// getDataSourceAccess はデータソースにアクセスできるグループ（とそのメンバー）を取得
+func (h *Handler) getDataSourceAccess(args map[string]interface{}) mcp.CallToolResult {
+	// data_source_id の取得
+	dataSourceIDFloat, ok := args["data_source_id"].(float64)
+	if !ok {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: "data_source_id must be a number",
+				},
+			},
+			IsError: true,
+		}
+	}
+	dataSourceID := int(dataSourceIDFloat)
+
+	// include_members の取得（オプション）
+	includeMembers, _ := args["include_members"].(bool)
+
+	// Redash API を呼び出し
+	dataSource, err := h.redashClient.GetDataSource(dataSourceID)
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to get data source: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	groups, err := h.redashClient.ListGroups()
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to list groups: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+	names := make(map[int]string, len(groups))
+	for _, group := range groups {
+		names[group.ID] = group.Name
+	}
+
+	// データソースの groups はグループID（文字列）→ 閲覧のみか
+	result := dataSourceAccess{
+		DataSourceID: dataSource.ID,
+		Name:         dataSource.Name,
+		Groups:       []dataSourceGroupAccess{},
+	}
+	for key, viewOnly := range dataSource.Groups {
+		groupID, err := strconv.Atoi(key)
+		if err != nil {
+			continue
+		}
+		access := dataSourceGroupAccess{
+			GroupID:  groupID,
+			Name:     names[groupID],
+			ViewOnly: viewOnly,
+		}
+
+		if includeMembers {
+			members, err := h.redashClient.ListGroupMembers(groupID)
+			if err != nil {
+				return mcp.CallToolResult{
+					Content: []mcp.Content{
+						{
+							Type: "text",
+							Text: fmt.Sprintf("Failed to list members of group %d: %v", groupID, err),
+						},
+					},
+					IsError: true,
+				}
+			}
+			access.Members = members
+		}
+
+		result.Groups = append(result.Groups, access)
+	}
+	sort.Slice(result.Groups, func(i, j int) bool {
+		return result.Groups[i].GroupID < result.Groups[j].GroupID
+	})
+
+	// JSON として整形して返す
+	formatted, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to format data source access: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	return mcp.CallToolResult{
+		Content: []mcp.Content{
+			{
+				Type: "text",
+				Text: string(formatted),
+			},
+		},
+		IsError: false,
+	}
+}
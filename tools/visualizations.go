@@ -0,0 +1,421 @@
+package tools
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/shshimamo/redash-mcp-go/mcp"
+	"github.com/shshimamo/redash-mcp-go/redash"
+)
+
+// getVisualization はビジュアライゼーションを取得
+func (h *Handler) getVisualization(args map[string]interface{}) mcp.CallToolResult {
+	// query_id の取得
+	queryIDFloat, ok := args["query_id"].(float64)
+	if !ok {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: "query_id must be a number",
+				},
+			},
+			IsError: true,
+		}
+	}
+	queryID := int(queryIDFloat)
+
+	// visualization_id の取得
+	visualizationIDFloat, ok := args["visualization_id"].(float64)
+	if !ok {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: "visualization_id must be a number",
+				},
+			},
+			IsError: true,
+		}
+	}
+	visualizationID := int(visualizationIDFloat)
+
+	// Redash API を呼び出し
+	visualization, err := h.redashClient.GetVisualization(queryID, visualizationID)
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to get visualization: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	// JSON として整形して返す
+	formatted, err := json.MarshalIndent(visualization, "", "  ")
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to format visualization: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	return mcp.CallToolResult{
+		Content: []mcp.Content{
+			{
+				Type: "text",
+				Text: string(formatted),
+			},
+		},
+		IsError: false,
+	}
+}
+
+// createVisualization はクエリに新しいビジュアライゼーションを追加
+func (h *Handler) createVisualization(args map[string]interface{}) mcp.CallToolResult {
+	// query_id の取得
+	queryIDFloat, ok := args["query_id"].(float64)
+	if !ok {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: "query_id must be a number",
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	// type の取得
+	visualizationType, ok := args["type"].(string)
+	if !ok || !isVisualizationType(visualizationType) {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("type must be one of: %s", strings.Join(redash.VisualizationTypes, ", ")),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	// name の取得
+	name, ok := args["name"].(string)
+	if !ok || name == "" {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: "name must be a non-empty string",
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	input := redash.CreateVisualizationInput{
+		QueryID: int(queryIDFloat),
+		Type:    visualizationType,
+		Name:    name,
+		Options: map[string]interface{}{},
+	}
+
+	// description の取得（オプション）
+	if description, ok := args["description"].(string); ok {
+		input.Description = description
+	}
+
+	// options の取得（オプション）
+	if value, exists := args["options"]; exists {
+		options, ok := value.(map[string]interface{})
+		if !ok {
+			return mcp.CallToolResult{
+				Content: []mcp.Content{
+					{
+						Type: "text",
+						Text: "options must be an object",
+					},
+				},
+				IsError: true,
+			}
+		}
+		if err := redash.ValidateVisualizationOptions(visualizationType, options); err != nil {
+			return mcp.CallToolResult{
+				Content: []mcp.Content{
+					{
+						Type: "text",
+						Text: err.Error(),
+					},
+				},
+				IsError: true,
+			}
+		}
+		input.Options = options
+	}
+
+	// Redash API を呼び出し
+	visualization, err := h.redashClient.CreateVisualization(input)
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to create visualization: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	// JSON として整形して返す
+	formatted, err := json.MarshalIndent(visualization, "", "  ")
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to format visualization: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	return mcp.CallToolResult{
+		Content: []mcp.Content{
+			{
+				Type: "text",
+				Text: string(formatted),
+			},
+		},
+		IsError: false,
+	}
+}
+
+// updateVisualization は既存のビジュアライゼーションを更新
+func (h *Handler) updateVisualization(args map[string]interface{}) mcp.CallToolResult {
+	// query_id の取得
+	queryIDFloat, ok := args["query_id"].(float64)
+	if !ok {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: "query_id must be a number",
+				},
+			},
+			IsError: true,
+		}
+	}
+	queryID := int(queryIDFloat)
+
+	// visualization_id の取得
+	visualizationIDFloat, ok := args["visualization_id"].(float64)
+	if !ok {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: "visualization_id must be a number",
+				},
+			},
+			IsError: true,
+		}
+	}
+	visualizationID := int(visualizationIDFloat)
+
+	// options をマージするため、現在のビジュアライゼーションを取得
+	current, err := h.redashClient.GetVisualization(queryID, visualizationID)
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to get visualization: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	var input redash.UpdateVisualizationInput
+	visualizationType := current.Type
+
+	// type の取得（オプション）
+	if value, exists := args["type"]; exists {
+		newType, ok := value.(string)
+		if !ok || !isVisualizationType(newType) {
+			return mcp.CallToolResult{
+				Content: []mcp.Content{
+					{
+						Type: "text",
+						Text: fmt.Sprintf("type must be one of: %s", strings.Join(redash.VisualizationTypes, ", ")),
+					},
+				},
+				IsError: true,
+			}
+		}
+		input.Type = &newType
+		visualizationType = newType
+	}
+
+	// name の取得（オプション）
+	if name, ok := args["name"].(string); ok {
+		input.Name = &name
+	}
+
+	// description の取得（オプション）
+	if description, ok := args["description"].(string); ok {
+		input.Description = &description
+	}
+
+	// options の取得（オプション）
+	// 指定されたキーだけを現在の options に上書きする
+	if value, exists := args["options"]; exists {
+		options, ok := value.(map[string]interface{})
+		if !ok {
+			return mcp.CallToolResult{
+				Content: []mcp.Content{
+					{
+						Type: "text",
+						Text: "options must be an object",
+					},
+				},
+				IsError: true,
+			}
+		}
+		if err := redash.ValidateVisualizationOptions(visualizationType, options); err != nil {
+			return mcp.CallToolResult{
+				Content: []mcp.Content{
+					{
+						Type: "text",
+						Text: err.Error(),
+					},
+				},
+				IsError: true,
+			}
+		}
+
+		merged := make(map[string]interface{})
+		if len(current.Options) > 0 {
+			if err := json.Unmarshal(current.Options, &merged); err != nil {
+				return mcp.CallToolResult{
+					Content: []mcp.Content{
+						{
+							Type: "text",
+							Text: fmt.Sprintf("Failed to parse current options: %v", err),
+						},
+					},
+					IsError: true,
+				}
+			}
+		}
+		for key, value := range options {
+			merged[key] = value
+		}
+		input.Options = merged
+	}
+
+	// Redash API を呼び出し
+	visualization, err := h.redashClient.UpdateVisualization(visualizationID, input)
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to update visualization: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	// JSON として整形して返す
+	formatted, err := json.MarshalIndent(visualization, "", "  ")
+	if err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to format visualization: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	return mcp.CallToolResult{
+		Content: []mcp.Content{
+			{
+				Type: "text",
+				Text: string(formatted),
+			},
+		},
+		IsError: false,
+	}
+}
+
+// deleteVisualization はビジュアライゼーションを削除
+func (h *Handler) deleteVisualization(args map[string]interface{}) mcp.CallToolResult {
+	// visualization_id の取得
+	visualizationIDFloat, ok := args["visualization_id"].(float64)
+	if !ok {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: "visualization_id must be a number",
+				},
+			},
+			IsError: true,
+		}
+	}
+	visualizationID := int(visualizationIDFloat)
+
+	log.Printf("Deleting visualization %d", visualizationID)
+
+	// Redash API を呼び出し
+	if err := h.redashClient.DeleteVisualization(visualizationID); err != nil {
+		return mcp.CallToolResult{
+			Content: []mcp.Content{
+				{
+					Type: "text",
+					Text: fmt.Sprintf("Failed to delete visualization: %v", err),
+				},
+			},
+			IsError: true,
+		}
+	}
+
+	return mcp.CallToolResult{
+		Content: []mcp.Content{
+			{
+				Type: "text",
+				Text: fmt.Sprintf("Visualization %d deleted", visualizationID),
+			},
+		},
+		IsError: false,
+	}
+}
+
+// isVisualizationType は Redash のビジュアライゼーションの種類かどうかを判定
+func isVisualizationType(visualizationType string) bool {
+	for _, t := range redash.VisualizationTypes {
+		if t == visualizationType {
+			return true
+		}
+	}
+	return false
+}